@@ -0,0 +1,16 @@
+package com.astraios.auth.config;
+
+import lombok.Data;
+import org.springframework.boot.context.properties.ConfigurationProperties;
+import org.springframework.stereotype.Component;
+
+@Component
+@ConfigurationProperties(prefix = "auth.internal")
+@Data
+public class InternalAuthProperties {
+
+    /**
+     * 内部服务调用 IssueToken / RevokeTokens 时需携带的共享凭证，未配置时服务拒绝启动
+     */
+    private String token;
+}
@@ -2,4 +2,8 @@ package com.astraios.auth.contants;
 
 public class AuthConstants {
     public static final String REDIS_REFRESH_TOKEN_PREFIX = "auth:refresh_token:";
+    // user-service VerifyPassword code for a username locked after repeated failures.
+    public static final int USER_CODE_LOCKED = 4;
+    // gRPC metadata key carrying the shared credential of internal callers.
+    public static final String INTERNAL_TOKEN_HEADER = "x-internal-token";
 }
@@ -0,0 +1,8 @@
+package com.astraios.auth.domain.dto;
+
+import lombok.Data;
+
+@Data
+public class IssueTokenRequest {
+    String userId;
+}
@@ -1,5 +1,6 @@
 package com.astraios.auth.grpc;
 
+import com.astraios.auth.domain.dto.IssueTokenRequest;
 import com.astraios.auth.domain.dto.LoginRequest;
 import com.astraios.auth.domain.dto.RefreshRequest;
 import com.astraios.auth.domain.dto.RegisterRequest;
@@ -15,6 +16,7 @@ import com.astraios.grpc.auth.LoginResponse;
 import com.astraios.grpc.auth.RegisterResponse;
 import com.astraios.grpc.auth.RefreshTokenRequest;
 import com.astraios.grpc.auth.RefreshTokenResponse;
+import com.astraios.grpc.auth.RevokeTokensRequest;
 import com.astraios.grpc.auth.Empty;
 import io.grpc.Status;
 import io.grpc.StatusRuntimeException;
@@ -133,6 +135,57 @@ public class AuthServiceGrpcImpl extends AuthServiceGrpc.AuthServiceImplBase {
         }
     }
 
+    @Override
+    public void issueToken(com.astraios.grpc.auth.IssueTokenRequest request, StreamObserver<LoginResponse> responseObserver) {
+        try {
+            log.info("收到签发令牌请求: userId={}", request.getUserId());
+
+            if (request.getUserId().isBlank()) {
+                respondError(responseObserver, Status.INVALID_ARGUMENT, "user_id is blank", null);
+                return;
+            }
+
+            // 转换gRPC请求为内部DTO
+            IssueTokenRequest issueTokenRequest = new IssueTokenRequest();
+            issueTokenRequest.setUserId(request.getUserId());
+
+            // 调用业务服务
+            LoginResult result = authService.issueToken(issueTokenRequest);
+
+            // 构建gRPC响应
+            LoginResponse response = LoginResponse.newBuilder()
+                    .setAccessToken(result.getAccessToken() != null ? result.getAccessToken() : "")
+                    .setRefreshToken(result.getRefreshToken() != null ? result.getRefreshToken() : "")
+                    .build();
+
+            responseObserver.onNext(response);
+            responseObserver.onCompleted();
+        } catch (Exception e) {
+            log.error("签发令牌失败", e);
+            handleException(responseObserver, e);
+        }
+    }
+
+    @Override
+    public void revokeTokens(RevokeTokensRequest request, StreamObserver<Empty> responseObserver) {
+        try {
+            log.info("收到吊销令牌请求: userId={}", request.getUserId());
+
+            if (request.getUserId().isBlank()) {
+                respondError(responseObserver, Status.INVALID_ARGUMENT, "user_id is blank", null);
+                return;
+            }
+
+            authService.revokeTokens(request.getUserId());
+
+            responseObserver.onNext(Empty.getDefaultInstance());
+            responseObserver.onCompleted();
+        } catch (Exception e) {
+            log.error("吊销令牌失败", e);
+            handleException(responseObserver, e);
+        }
+    }
+
     @Override
     public void getJwks(Empty request, StreamObserver<JwksResponse> responseObserver) {
         try {
@@ -150,6 +203,9 @@ public class AuthServiceGrpcImpl extends AuthServiceGrpc.AuthServiceImplBase {
                         setIfPresent(jwkBuilder::setAlg, keyMap.get("alg"));
                         setIfPresent(jwkBuilder::setN, keyMap.get("n"));
                         setIfPresent(jwkBuilder::setE, keyMap.get("e"));
+                        setIfPresent(jwkBuilder::setCrv, keyMap.get("crv"));
+                        setIfPresent(jwkBuilder::setX, keyMap.get("x"));
+                        setIfPresent(jwkBuilder::setY, keyMap.get("y"));
                         responseBuilder.addKeys(jwkBuilder.build());
                     }
                 }
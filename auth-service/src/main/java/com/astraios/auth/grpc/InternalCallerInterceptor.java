@@ -0,0 +1,66 @@
+package com.astraios.auth.grpc;
+
+import com.astraios.auth.config.InternalAuthProperties;
+import com.astraios.auth.contants.AuthConstants;
+import com.astraios.grpc.auth.AuthServiceGrpc;
+import io.grpc.Metadata;
+import io.grpc.ServerCall;
+import io.grpc.ServerCallHandler;
+import io.grpc.ServerInterceptor;
+import io.grpc.Status;
+import jakarta.annotation.PostConstruct;
+import lombok.RequiredArgsConstructor;
+import lombok.extern.slf4j.Slf4j;
+import net.devh.boot.grpc.server.interceptor.GrpcGlobalServerInterceptor;
+import org.springframework.util.StringUtils;
+
+import java.nio.charset.StandardCharsets;
+import java.security.MessageDigest;
+import java.util.Set;
+
+/**
+ * 校验内部调用方凭证：IssueToken 与 RevokeTokens 可为任意用户签发或吊销令牌，只允许持有共享凭证的内部服务调用
+ */
+@Slf4j
+@GrpcGlobalServerInterceptor
+@RequiredArgsConstructor
+public class InternalCallerInterceptor implements ServerInterceptor {
+
+    private static final Metadata.Key<String> INTERNAL_TOKEN_KEY =
+            Metadata.Key.of(AuthConstants.INTERNAL_TOKEN_HEADER, Metadata.ASCII_STRING_MARSHALLER);
+
+    private static final Set<String> INTERNAL_METHODS = Set.of(
+            AuthServiceGrpc.getIssueTokenMethod().getFullMethodName(),
+            AuthServiceGrpc.getRevokeTokensMethod().getFullMethodName()
+    );
+
+    private final InternalAuthProperties properties;
+
+    @PostConstruct
+    void validate() {
+        if (!StringUtils.hasText(properties.getToken())) {
+            throw new IllegalStateException("auth.internal.token must be configured");
+        }
+    }
+
+    @Override
+    public <ReqT, RespT> ServerCall.Listener<ReqT> interceptCall(ServerCall<ReqT, RespT> call,
+                                                                 Metadata headers,
+                                                                 ServerCallHandler<ReqT, RespT> next) {
+        String method = call.getMethodDescriptor().getFullMethodName();
+        if (!INTERNAL_METHODS.contains(method)) {
+            return next.startCall(call, headers);
+        }
+
+        String presented = headers.get(INTERNAL_TOKEN_KEY);
+        if (presented == null || !MessageDigest.isEqual(
+                presented.getBytes(StandardCharsets.UTF_8),
+                properties.getToken().getBytes(StandardCharsets.UTF_8))) {
+            log.warn("拒绝未认证的内部调用: method={}", method);
+            call.close(Status.UNAUTHENTICATED.withDescription("internal caller credential required"), new Metadata());
+            return new ServerCall.Listener<>() {
+            };
+        }
+        return next.startCall(call, headers);
+    }
+}
@@ -1,5 +1,6 @@
 package com.astraios.auth.service;
 
+import com.astraios.auth.domain.dto.IssueTokenRequest;
 import com.astraios.auth.domain.dto.LoginRequest;
 import com.astraios.auth.domain.dto.RefreshRequest;
 import com.astraios.auth.domain.vo.LoginResult;
@@ -17,4 +18,8 @@ public interface AuthService {
     RegisterResult register(RegisterRequest request);
 
     RefreshResult refreshToken(RefreshRequest request);
+
+    LoginResult issueToken(IssueTokenRequest request);
+
+    void revokeTokens(String userId);
 }
@@ -1,6 +1,7 @@
 package com.astraios.auth.service.impl;
 
 import com.astraios.auth.contants.AuthConstants;
+import com.astraios.auth.domain.dto.IssueTokenRequest;
 import com.astraios.auth.domain.dto.LoginRequest;
 import com.astraios.auth.domain.dto.RefreshRequest;
 import com.astraios.auth.domain.dto.RegisterRequest;
@@ -10,8 +11,8 @@ import com.astraios.auth.domain.vo.RegisterResult;
 import com.astraios.auth.exception.GrpcStatusException;
 import com.astraios.auth.service.AuthService;
 import com.astraios.auth.utils.JwtTokenProvider;
-import com.astraios.grpc.user.UserDataRequest;
-import com.astraios.grpc.user.UserDataResponse;
+import com.astraios.grpc.user.UserRolesRequest;
+import com.astraios.grpc.user.UserRolesResponse;
 import com.astraios.grpc.user.UserServiceGrpc;
 import com.astraios.grpc.user.VerifyPasswordRequest;
 import com.astraios.grpc.user.VerifyPasswordResponse;
@@ -24,6 +25,7 @@ import org.springframework.data.redis.core.StringRedisTemplate;
 import org.springframework.stereotype.Service;
 import org.springframework.util.StringUtils;
 
+import java.util.List;
 import java.util.concurrent.TimeUnit;
 
 @Service
@@ -48,13 +50,28 @@ public class AuthServiceImpl implements AuthService {
                 .build();
 
         VerifyPasswordResponse rpcResponse = verifyPassword(rpcRequest);
+        if (rpcResponse.getCode() == AuthConstants.USER_CODE_LOCKED) {
+            throw new GrpcStatusException(Status.RESOURCE_EXHAUSTED, "Too many failed attempts, try again later");
+        }
         if (rpcResponse.getCode() != 0) {
             throw new GrpcStatusException(Status.UNAUTHENTICATED, "Invalid username or password");
         }
 
-        String userId = rpcResponse.getUserId();
-        String accessToken = jwtTokenProvider.generateAccessToken(userId, request.getUsername());
-        String refreshToken = jwtTokenProvider.generateRefreshToken(userId);
+        return issueTokens(rpcResponse.getUserId(), request.getUsername(), rpcResponse.getRolesList());
+    }
+
+    @Override
+    public LoginResult issueToken(IssueTokenRequest request) {
+        // The caller only vouches for who the user is; username and roles come from user-service
+        // so an internal caller cannot mint a token with privileges the account does not hold.
+        String redisKey = AuthConstants.REDIS_REFRESH_TOKEN_PREFIX + request.getUserId();
+        UserRolesResponse account = getUserRoles(redisKey, UserRolesRequest.newBuilder().setUserId(request.getUserId()).build());
+        return issueTokens(request.getUserId(), account.getUsername(), account.getRolesList());
+    }
+
+    private LoginResult issueTokens(String userId, String username, List<String> roles) {
+        String accessToken = jwtTokenProvider.generateAccessToken(userId, username, roles);
+        String refreshToken = jwtTokenProvider.generateRefreshToken(userId, roles);
 
         String redisKey = AuthConstants.REDIS_REFRESH_TOKEN_PREFIX + userId;
         redisTemplate.opsForValue().set(
@@ -70,6 +87,11 @@ public class AuthServiceImpl implements AuthService {
         return loginResult;
     }
 
+    @Override
+    public void revokeTokens(String userId) {
+        redisTemplate.delete(AuthConstants.REDIS_REFRESH_TOKEN_PREFIX + userId);
+    }
+
     @Override
     public RegisterResult register(RegisterRequest request) {
         validateCredentials(request.getUsername(), request.getPassword());
@@ -105,9 +127,12 @@ public class AuthServiceImpl implements AuthService {
             throw new GrpcStatusException(Status.UNAUTHENTICATED, "Refresh token expired or invalid");
         }
 
-        UserDataResponse userData = getUserData(UserDataRequest.newBuilder().setUserId(userId).build());
-        String newAccessToken = jwtTokenProvider.generateAccessToken(userId, userData.getNickname());
-        String newRefreshToken = jwtTokenProvider.generateRefreshToken(userId);
+        // Roles are read again rather than copied from the old token, so a revoked role or a
+        // disabled account does not survive a refresh.
+        UserRolesResponse account = getUserRoles(redisKey, UserRolesRequest.newBuilder().setUserId(userId).build());
+        List<String> roles = account.getRolesList();
+        String newAccessToken = jwtTokenProvider.generateAccessToken(userId, account.getUsername(), roles);
+        String newRefreshToken = jwtTokenProvider.generateRefreshToken(userId, roles);
 
         redisTemplate.opsForValue().set(
                 redisKey,
@@ -148,10 +173,19 @@ public class AuthServiceImpl implements AuthService {
         }
     }
 
-    private UserDataResponse getUserData(UserDataRequest request) {
+    /**
+     * Loads the current roles of the account. An account that is gone or disabled loses its
+     * refresh token instead of getting new ones.
+     */
+    private UserRolesResponse getUserRoles(String refreshKey, UserRolesRequest request) {
         try {
-            return userServiceStub.getUserData(request);
+            return userServiceStub.getUserRoles(request);
         } catch (StatusRuntimeException e) {
+            Status.Code code = e.getStatus().getCode();
+            if (code == Status.Code.NOT_FOUND || code == Status.Code.PERMISSION_DENIED) {
+                redisTemplate.delete(refreshKey);
+                throw new GrpcStatusException(Status.UNAUTHENTICATED, "Account is no longer active", e);
+            }
             throw new GrpcStatusException(Status.UNAVAILABLE, USER_SERVICE_UNAVAILABLE, e);
         } catch (Exception e) {
             throw new GrpcStatusException(Status.UNAVAILABLE, USER_SERVICE_UNAVAILABLE, e);
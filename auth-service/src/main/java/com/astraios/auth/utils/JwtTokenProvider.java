@@ -41,6 +41,9 @@ public class JwtTokenProvider {
 
     private static final String ISSUER = "astraios";
     private static final String CLAIM_KEY_TYPE = "token_type";
+    private static final String CLAIM_KEY_ROLES = "roles";
+    // 毫秒精度的签发时间：iat 只精确到秒，网关据此区分与会话吊销同一秒内的重新登录
+    private static final String CLAIM_KEY_ISSUED_AT_MS = "iat_ms";
     private static final String TYPE_ACCESS = "access";
     private static final String TYPE_REFRESH = "refresh";
 
@@ -218,24 +221,41 @@ public class JwtTokenProvider {
     }
 
     public String generateAccessToken(String userId, String username) {
+        return generateAccessToken(userId, username, List.of());
+    }
+
+    public String generateAccessToken(String userId, String username, List<String> roles) {
         Map<String, Object> claims = new HashMap<>();
         claims.put("username", username);
+        long now = System.currentTimeMillis();
         claims.put(CLAIM_KEY_TYPE, TYPE_ACCESS);
+        claims.put(CLAIM_KEY_ROLES, roles == null ? List.of() : roles);
+        claims.put(CLAIM_KEY_ISSUED_AT_MS, now);
 
         return Jwts.builder()
                 .header().keyId(KEY_ID).and()
                 .claims(claims)
                 .subject(userId)
                 .issuer(ISSUER)
-                .issuedAt(new Date())
-                .expiration(new Date(System.currentTimeMillis() + ACCESS_TOKEN_EXPIRATION))
+                .issuedAt(new Date(now))
+                .expiration(new Date(now + ACCESS_TOKEN_EXPIRATION))
                 .signWith(keyPair.getPrivate(), Jwts.SIG.RS256)
                 .compact();
     }
 
     public String generateRefreshToken(String userId) {
+        return generateRefreshToken(userId, List.of());
+    }
+
+    /**
+     * 刷新令牌携带的角色仅为签发时的快照，刷新时会重新向 user-service 查询角色，不会沿用该值
+     */
+    public String generateRefreshToken(String userId, List<String> roles) {
         Map<String, Object> claims = new HashMap<>();
+        long now = System.currentTimeMillis();
         claims.put(CLAIM_KEY_TYPE, TYPE_REFRESH);
+        claims.put(CLAIM_KEY_ROLES, roles == null ? List.of() : roles);
+        claims.put(CLAIM_KEY_ISSUED_AT_MS, now);
 
         return Jwts.builder()
                 .header().keyId(KEY_ID).and()
@@ -243,8 +263,8 @@ public class JwtTokenProvider {
                 .id(UUID.randomUUID().toString())
                 .subject(userId)
                 .issuer(ISSUER)
-                .issuedAt(new Date())
-                .expiration(new Date(System.currentTimeMillis() + REFRESH_TOKEN_EXPIRATION))
+                .issuedAt(new Date(now))
+                .expiration(new Date(now + REFRESH_TOKEN_EXPIRATION))
                 .signWith(keyPair.getPrivate(), Jwts.SIG.RS256)
                 .compact();
     }
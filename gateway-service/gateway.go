@@ -9,10 +9,13 @@ import (
 
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/conf"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/config"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/errorx"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/handler"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
 
 	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
 var configFile = flag.String("f", "etc/gateway.yaml", "the config file")
@@ -23,9 +26,15 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 
-	server := rest.MustNewServer(c.RestConf)
+	server := rest.MustNewServer(c.RestConf,
+		rest.WithNotFoundHandler(errorx.NotFoundHandler()),
+		rest.WithNotAllowedHandler(errorx.NotAllowedHandler()))
 	defer server.Stop()
 
+	// Every error, from handlers and middlewares alike, goes out in the same envelope.
+	httpx.SetErrorHandlerCtx(errorx.Handler)
+	server.Use(middleware.NewRequestIDMiddleware().Handle)
+
 	ctx := svc.NewServiceContext(c)
 	handler.RegisterHandlers(server, ctx)
 
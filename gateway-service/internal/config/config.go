@@ -15,11 +15,61 @@ type Config struct {
 	ConfigDataId  string `json:",optional"`
 	UserService   zrpc.RpcClientConf
 	AuthService   zrpc.RpcClientConf
+	// InternalToken is sent to auth-service as x-internal-token on every call; IssueToken and
+	// RevokeTokens reject callers without it. It must match auth-service's auth.internal.token.
+	InternalToken string
 	JwtAuth       JwtAuthConf     `json:",optional"`
 	CacheRedis    redis.RedisConf `json:"cacheRedis,optional"`
+	RateLimit     RateLimitConf   `json:",optional"`
+	OAuth         OAuthConf       `json:",optional"`
 }
 
 type JwtAuthConf struct {
 	Issuer       string `json:",optional"`
 	CacheSeconds int64  `json:",default=300"`
+	// RefetchSeconds is the minimum gap between on-demand JWKS fetches triggered by an unknown kid.
+	RefetchSeconds int64 `json:",default=10"`
+	// RevokeSeconds is how long a "log out everywhere" watermark is kept.
+	// It must cover the lifetime of the longest-lived token (refresh token, 7 days).
+	RevokeSeconds int64 `json:",default=604800"`
+	// LocalRevocation mirrors revoked tokens and session watermarks in memory, synced from redis
+	// pub/sub, so authenticated requests skip the per-request redis lookup.
+	LocalRevocation bool `json:",default=true"`
+	// LocalRevocationMaxEntries bounds the in-memory mirror; beyond it lookups go to redis again.
+	LocalRevocationMaxEntries int `json:",default=1000000"`
+	// RedisFailPolicy decides what a failed redis revocation lookup means:
+	// "closed" rejects the request with 401, "open" lets it through and logs the error.
+	RedisFailPolicy string `json:",default=closed,options=open|closed"`
+}
+
+// RateLimitConf configures the sliding-window limiter in front of login and register.
+type RateLimitConf struct {
+	Enabled       bool  `json:",default=true"`
+	WindowSeconds int64 `json:",default=60"`
+	// PerIP is how many requests one client IP may send to each endpoint per window.
+	PerIP int `json:",default=20"`
+	// PerUsername is how many requests may name the same username per window, across all IPs.
+	PerUsername int `json:",default=5"`
+	// TrustedProxies lists the addresses or CIDRs of the reverse proxies in front of the gateway.
+	// Only requests arriving from them have their X-Real-IP or X-Forwarded-For honored; every
+	// other client is limited by its socket address.
+	TrustedProxies []string `json:",optional"`
+}
+
+// OAuthConf configures third-party login. A platform is enabled by listing a provider for it.
+type OAuthConf struct {
+	// StateSeconds is how long an authorize request may take before its state expires.
+	StateSeconds int                 `json:",default=600"`
+	Providers    []OAuthProviderConf `json:",optional"`
+}
+
+type OAuthProviderConf struct {
+	// Name is the platform in routes and in t_user_oauth.platform, e.g. "wechat".
+	Name string
+	// Type selects the implementation. "fake" accepts any code and is meant for local development and tests.
+	Type         string `json:",default=fake,options=fake"`
+	ClientID     string `json:",optional"`
+	ClientSecret string `json:",optional"`
+	// RedirectURI is the callback registered with the provider.
+	RedirectURI string
 }
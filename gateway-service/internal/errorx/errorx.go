@@ -0,0 +1,203 @@
+package errorx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strings"
+
+	httpstatuscode "github.com/GUET-BAT/Astraios-S/global/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	LangZh = "zh"
+	LangEn = "en"
+)
+
+type (
+	ctxKeyRequestID struct{}
+	ctxKeyLang      struct{}
+)
+
+// Response is the body of every error the gateway returns.
+// Reason, Fields and RetryAfter are filled from the upstream status details when present.
+type Response struct {
+	Code       int          `json:"code"`
+	Message    string       `json:"message"`
+	RequestID  string       `json:"request_id,omitempty"`
+	Reason     string       `json:"reason,omitempty"`
+	Fields     []FieldError `json:"fields,omitempty"`
+	RetryAfter int64        `json:"retry_after,omitempty"`
+}
+
+// FieldError describes one invalid request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// messages holds the localized text for each business code, keyed by language.
+var messages = map[int]map[string]string{
+	httpstatuscode.CodeInvalidParam:    {LangZh: "参数无效", LangEn: "invalid parameters"},
+	httpstatuscode.CodeUnanthorized:    {LangZh: "未登录或登录已失效", LangEn: "unauthorized"},
+	httpstatuscode.CodeForbidden:       {LangZh: "没有权限", LangEn: "forbidden"},
+	httpstatuscode.CodeTooManyRequests: {LangZh: "请求过于频繁，请稍后再试", LangEn: "too many requests"},
+	httpstatuscode.CodeAlreadyExists:   {LangZh: "资源已存在", LangEn: "already exists"},
+	httpstatuscode.CodeNotFound:        {LangZh: "资源不存在", LangEn: "not found"},
+	httpstatuscode.CodeInternalError:   {LangZh: "内部错误", LangEn: "internal error"},
+	httpstatuscode.CodeUnavailable:     {LangZh: "服务暂不可用", LangEn: "service unavailable"},
+	httpstatuscode.CodeTimeout:         {LangZh: "请求超时", LangEn: "request timeout"},
+	httpstatuscode.CodeNotImplemented:  {LangZh: "暂不支持", LangEn: "not implemented"},
+}
+
+// Handler converts an error into the gateway envelope. Register it with httpx.SetErrorHandlerCtx.
+// gRPC status errors are mapped by code; any other error is unexpected and becomes a 500, so
+// request parsing errors must be wrapped with BadRequest. A 4xx carries the status message, which
+// tells the client what to fix; a 5xx only carries the localized generic text.
+func Handler(ctx context.Context, err error) (int, any) {
+	st := toStatus(err)
+	httpStatus, code := FromGrpcCode(st.Code())
+	if httpStatus >= http.StatusInternalServerError {
+		logx.WithContext(ctx).Errorf("request failed: %v", err)
+	}
+
+	resp := &Response{
+		Code:      code,
+		Message:   Message(code, LanguageFromContext(ctx)),
+		RequestID: RequestIDFromContext(ctx),
+	}
+	if httpStatus < http.StatusInternalServerError && st.Message() != "" {
+		resp.Message = st.Message()
+	}
+	applyDetails(resp, st)
+	return httpStatus, resp
+}
+
+// BadRequest marks an error from parsing the request as the client's fault.
+func BadRequest(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// applyDetails copies the client-safe parts of rich status details into resp.
+func applyDetails(resp *Response, st *status.Status) {
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			resp.Reason = d.GetReason()
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				resp.Fields = append(resp.Fields, FieldError{Field: v.GetField(), Message: v.GetDescription()})
+			}
+		case *errdetails.RetryInfo:
+			if delay := d.GetRetryDelay(); delay != nil {
+				resp.RetryAfter = int64(math.Ceil(delay.AsDuration().Seconds()))
+			}
+		}
+	}
+}
+
+// NotFoundHandler and NotAllowedHandler answer unmatched routes with the same envelope.
+func NotFoundHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithLanguage(r.Context(), ParseLanguage(r.Header.Get("Accept-Language")))
+		httpx.ErrorCtx(ctx, w, status.Error(codes.NotFound, "route not found"))
+	})
+}
+
+func NotAllowedHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpx.WriteJsonCtx(r.Context(), w, http.StatusMethodNotAllowed, &Response{
+			Code:    httpstatuscode.CodeInvalidParam,
+			Message: Message(httpstatuscode.CodeInvalidParam, ParseLanguage(r.Header.Get("Accept-Language"))),
+		})
+	})
+}
+
+// FromGrpcCode maps a gRPC code to an HTTP status and a business code.
+func FromGrpcCode(code codes.Code) (int, int) {
+	switch code {
+	case codes.OK:
+		return http.StatusOK, httpstatuscode.CodeSuccess
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest, httpstatuscode.CodeInvalidParam
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized, httpstatuscode.CodeUnanthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden, httpstatuscode.CodeForbidden
+	case codes.NotFound:
+		return http.StatusNotFound, httpstatuscode.CodeNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict, httpstatuscode.CodeAlreadyExists
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests, httpstatuscode.CodeTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented, httpstatuscode.CodeNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable, httpstatuscode.CodeUnavailable
+	case codes.DeadlineExceeded, codes.Canceled:
+		return http.StatusGatewayTimeout, httpstatuscode.CodeTimeout
+	default:
+		return http.StatusInternalServerError, httpstatuscode.CodeInternalError
+	}
+}
+
+// Message returns the text for code in lang, falling back to Chinese.
+func Message(code int, lang string) string {
+	texts, ok := messages[code]
+	if !ok {
+		texts = messages[httpstatuscode.CodeInternalError]
+	}
+	if text, ok := texts[lang]; ok {
+		return text
+	}
+	return texts[LangZh]
+}
+
+// ParseLanguage picks the first supported language from an Accept-Language header.
+func ParseLanguage(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.ToLower(strings.TrimSpace(strings.SplitN(part, ";", 2)[0]))
+		switch {
+		case strings.HasPrefix(tag, LangZh):
+			return LangZh
+		case strings.HasPrefix(tag, LangEn):
+			return LangEn
+		}
+	}
+	return LangZh
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, ctxKeyRequestID{}, requestID)
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(ctxKeyRequestID{}).(string)
+	return requestID
+}
+
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, ctxKeyLang{}, lang)
+}
+
+func LanguageFromContext(ctx context.Context) string {
+	lang, _ := ctx.Value(ctxKeyLang{}).(string)
+	return lang
+}
+
+func toStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err)
+	}
+	return status.New(codes.Internal, err.Error())
+}
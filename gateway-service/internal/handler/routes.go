@@ -13,17 +13,55 @@ import (
 )
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RateLimit},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/oauth/:platform/authorize",
+					Handler: user.OAuthAuthorizeHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/oauth/:platform/callback",
+					Handler: user.OAuthCallbackHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/login",
+					Handler: user.LoginHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/password/reset",
+					Handler: user.ResetPasswordHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/password/reset/request",
+					Handler: user.RequestPasswordResetHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/reactivate",
+					Handler: user.ReactivateAccountHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/register",
+					Handler: user.RegisterHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
 				Method:  http.MethodPost,
-				Path:    "/api/v1/users/login",
-				Handler: user.LoginHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/v1/users/register",
-				Handler: user.RegisterHandler(serverCtx),
+				Path:    "/api/v1/users/refresh",
+				Handler: user.RefreshHandler(serverCtx),
 			},
 		},
 	)
@@ -32,11 +70,96 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.JwtAuth},
 			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/users/:user_id/followers",
+					Handler: user.ListFollowersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/users/:user_id/following",
+					Handler: user.ListFollowingHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/users/:user_id/profile",
+					Handler: user.GetPublicProfileHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/avatar/confirm",
+					Handler: user.ConfirmAvatarHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/follow",
+					Handler: user.FollowHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/users/follow/status",
+					Handler: user.GetFollowStatusHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/identifiers",
+					Handler: user.BindIdentifierHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/identifiers/unbind",
+					Handler: user.UnbindIdentifierHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/identifiers/verify",
+					Handler: user.VerifyIdentifierHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodPost,
 					Path:    "/api/v1/users/logout",
 					Handler: user.LogoutHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/logout-all",
+					Handler: user.LogoutAllHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/v1/users/me",
+					Handler: user.DeactivateAccountHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/media/confirm",
+					Handler: user.ConfirmMediaHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/media/presign",
+					Handler: user.SetMediaHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/users/oauth",
+					Handler: user.ListOAuthBindingsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/users/oauth/:platform/authorize",
+					Handler: user.BindOAuthAuthorizeHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/oauth/unbind",
+					Handler: user.UnbindOAuthHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/password",
+					Handler: user.ChangePasswordHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodGet,
 					Path:    "/api/v1/users/presign-url",
@@ -47,6 +170,21 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/v1/users/presign-url",
 					Handler: user.SetAvatarHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/users/settings",
+					Handler: user.GetUserSettingsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPatch,
+					Path:    "/api/v1/users/settings",
+					Handler: user.UpdateUserSettingsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/users/unfollow",
+					Handler: user.UnfollowHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodGet,
 					Path:    "/api/v1/users/user-data",
@@ -60,4 +198,32 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 			}...,
 		),
 	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.JwtAuth, serverCtx.AdminAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/admin/users",
+					Handler: user.AdminSearchUsersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/admin/users/:user_id/bindings",
+					Handler: user.AdminGetUserBindingsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/admin/users/:user_id/logout",
+					Handler: user.AdminForceLogoutHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/admin/users/:user_id/status",
+					Handler: user.AdminSetUserStatusHandler(serverCtx),
+				},
+			}...,
+		),
+	)
 }
@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/errorx"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/logic/user"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func BindIdentifierHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.IdentifierRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, errorx.BadRequest(err))
+			return
+		}
+
+		l := user.NewBindIdentifierLogic(r.Context(), svcCtx)
+		resp, err := l.BindIdentifier(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
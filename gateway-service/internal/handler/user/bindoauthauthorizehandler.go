@@ -0,0 +1,42 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/errorx"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/logic/user"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/oauth"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func BindOAuthAuthorizeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OAuthAuthorizeRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, errorx.BadRequest(err))
+			return
+		}
+
+		nonce, err := oauth.NewNonce()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, status.Error(codes.Internal, "internal error"))
+			return
+		}
+
+		l := user.NewBindOAuthAuthorizeLogic(oauth.WithNonce(r.Context(), nonce), svcCtx)
+		resp, err := l.BindOAuthAuthorize(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			oauth.SetNonceCookie(w, nonce, svcCtx.Config.OAuth.StateSeconds)
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
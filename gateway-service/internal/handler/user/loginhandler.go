@@ -6,6 +6,7 @@ package user
 import (
 	"net/http"
 
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/errorx"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/logic/user"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
@@ -16,7 +17,7 @@ func LoginHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req types.LoginRequest
 		if err := httpx.Parse(r, &req); err != nil {
-			httpx.ErrorCtx(r.Context(), w, err)
+			httpx.ErrorCtx(r.Context(), w, errorx.BadRequest(err))
 			return
 		}
 
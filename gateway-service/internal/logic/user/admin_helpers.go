@@ -0,0 +1,24 @@
+package user
+
+import (
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+)
+
+// Account states an administrator may set, as stored by user-service.
+const (
+	userStatusDisabled int32 = 0
+	userStatusActive   int32 = 1
+)
+
+func toAdminUser(u *userpb.AdminUser) types.AdminUser {
+	return types.AdminUser{
+		UserId:    u.UserId,
+		Username:  u.Username,
+		Nickname:  u.Nickname,
+		Status:    u.Status,
+		CreatedAt: u.CreatedAt,
+		DeletedAt: u.DeletedAt,
+		Purged:    u.Purged,
+	}
+}
@@ -0,0 +1,56 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminForceLogoutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminForceLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminForceLogoutLogic {
+	return &AdminForceLogoutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminForceLogoutLogic) AdminForceLogout(req *types.AdminUserRequest) (resp *types.LogoutResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.UserId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if l.svcCtx.Revocations == nil {
+		l.Errorf("admin force logout: revocation store not configured")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	operatorID, _ := middleware.SubjectFromContext(l.ctx)
+
+	// Step 2: Invalidate every token the user was issued up to now.
+	userID := strings.TrimSpace(req.UserId)
+	if err := revokeUserSessions(l.ctx, l.svcCtx, userID); err != nil {
+		l.Errorf("admin force logout: set revocation watermark failed: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	l.Infof("admin force logout: sessions revoked, userId=%s operatorId=%s", userID, operatorID)
+	return &types.LogoutResponse{
+		Code: 0,
+		Msg:  "ok",
+	}, nil
+}
@@ -0,0 +1,73 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminGetUserBindingsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminGetUserBindingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminGetUserBindingsLogic {
+	return &AdminGetUserBindingsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminGetUserBindingsLogic) AdminGetUserBindings(req *types.AdminUserRequest) (resp *types.AdminUserBindingsResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.UserId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Step 2: Load the bindings.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.AdminGetUserBindings(ctx, &userpb.AdminUserRequest{
+		UserId: strings.TrimSpace(req.UserId),
+	})
+	if err != nil {
+		l.Errorf("admin get user bindings: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	data := types.AdminUserBindingsResponseData{
+		Identifiers:   make([]types.IdentifierResponseData, 0, len(rpcResp.Identifiers)),
+		OAuthBindings: make([]types.OAuthBinding, 0, len(rpcResp.OauthBindings)),
+	}
+	for _, identifier := range rpcResp.Identifiers {
+		data.Identifiers = append(data.Identifiers, types.IdentifierResponseData{
+			AuthType:   identifier.AuthType,
+			Identifier: identifier.Identifier,
+			Verified:   identifier.Verified,
+		})
+	}
+	for _, binding := range rpcResp.OauthBindings {
+		data.OAuthBindings = append(data.OAuthBindings, types.OAuthBinding{
+			Platform:  binding.Platform,
+			Nickname:  binding.Nickname,
+			Avatar:    binding.Avatar,
+			CreatedAt: binding.CreatedAt,
+		})
+	}
+	return &types.AdminUserBindingsResponse{
+		Code: 0,
+		Data: data,
+	}, nil
+}
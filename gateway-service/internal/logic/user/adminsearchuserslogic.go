@@ -0,0 +1,77 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminSearchUsersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminSearchUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminSearchUsersLogic {
+	return &AdminSearchUsersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminSearchUsersLogic) AdminSearchUsers(req *types.AdminSearchUsersRequest) (resp *types.AdminSearchUsersResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	var statuses []int32
+	for _, part := range strings.Split(req.Status, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(part, 10, 32)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid status filter")
+		}
+		statuses = append(statuses, int32(value))
+	}
+
+	// Step 2: Search accounts.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.AdminSearchUsers(ctx, &userpb.AdminSearchUsersRequest{
+		Username:      req.Username,
+		Nickname:      req.Nickname,
+		Statuses:      statuses,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		Cursor:        req.Cursor,
+		Limit:         req.Limit,
+	})
+	if err != nil {
+		l.Errorf("admin search users: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	users := make([]types.AdminUser, 0, len(rpcResp.Users))
+	for _, u := range rpcResp.Users {
+		users = append(users, toAdminUser(u))
+	}
+	return &types.AdminSearchUsersResponse{
+		Code: 0,
+		Data: types.AdminSearchUsersResponseData{Users: users, NextCursor: rpcResp.NextCursor},
+	}, nil
+}
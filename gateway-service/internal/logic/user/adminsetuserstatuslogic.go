@@ -0,0 +1,84 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminSetUserStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminSetUserStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminSetUserStatusLogic {
+	return &AdminSetUserStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminSetUserStatusLogic) AdminSetUserStatus(req *types.AdminSetUserStatusRequest) (resp *types.AdminUserResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.UserId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Status != userStatusDisabled && req.Status != userStatusActive {
+		return nil, status.Error(codes.InvalidArgument, "status must be 0 (disabled) or 1 (active)")
+	}
+	if req.Status == userStatusDisabled && l.svcCtx.Revocations == nil {
+		l.Errorf("admin set user status: revocation store not configured")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// Step 2: Read the operator from context (set by JwtAuth middleware).
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	operatorID := strings.TrimSpace(subject)
+	if !ok || operatorID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(req.UserId)
+	if userID == operatorID && req.Status == userStatusDisabled {
+		return nil, status.Error(codes.InvalidArgument, "cannot disable your own account")
+	}
+
+	// Step 3: Change the status.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.AdminSetUserStatus(ctx, &userpb.AdminSetUserStatusRequest{
+		UserId:     userID,
+		Status:     req.Status,
+		OperatorId: operatorID,
+	})
+	if err != nil {
+		l.Infof("admin set user status: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 4: A disabled account must not keep working through tokens issued earlier.
+	if req.Status == userStatusDisabled {
+		if err := revokeUserSessions(l.ctx, l.svcCtx, userID); err != nil {
+			l.Errorf("admin set user status: set revocation watermark failed: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	l.Infof("admin set user status: success, userId=%s status=%d operatorId=%s", userID, req.Status, operatorID)
+	return &types.AdminUserResponse{
+		Code: 0,
+		Data: toAdminUser(rpcResp),
+	}, nil
+}
@@ -0,0 +1,79 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BindIdentifierLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBindIdentifierLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindIdentifierLogic {
+	return &BindIdentifierLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BindIdentifierLogic) BindIdentifier(req *types.IdentifierRequest) (resp *types.IdentifierResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if strings.TrimSpace(req.Identifier) == "" {
+		return nil, status.Error(codes.InvalidArgument, "identifier is required")
+	}
+
+	// Step 2: Read user id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Build RPC request to user-service.
+	rpcReq := &userpb.IdentifierRequest{
+		UserId:     userID,
+		AuthType:   req.AuthType,
+		Identifier: req.Identifier,
+	}
+
+	// Step 4: Call user-service BindIdentifier with timeout.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.BindIdentifier(ctx, rpcReq)
+	if err != nil {
+		l.Errorf("bind identifier: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 5: Map RPC response to HTTP response.
+	return &types.IdentifierResponse{
+		Code: 0,
+		Data: types.IdentifierResponseData{
+			AuthType:      rpcResp.AuthType,
+			Identifier:    rpcResp.Identifier,
+			Verified:      rpcResp.Verified,
+			CodeExpiresIn: rpcResp.CodeExpiresIn,
+		},
+	}, nil
+}
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/oauth"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BindOAuthAuthorizeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBindOAuthAuthorizeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindOAuthAuthorizeLogic {
+	return &BindOAuthAuthorizeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BindOAuthAuthorizeLogic) BindOAuthAuthorize(req *types.OAuthAuthorizeRequest) (resp *types.OAuthAuthorizeResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || req.Platform == "" {
+		return nil, status.Error(codes.InvalidArgument, "platform is required")
+	}
+
+	// Step 2: Read user id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Record a bind state for this user and build the provider URL.
+	return startOAuth(l.ctx, l.svcCtx, req.Platform, oauth.ModeBind, userID)
+}
@@ -0,0 +1,83 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ChangePasswordLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewChangePasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangePasswordLogic {
+	return &ChangePasswordLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ChangePasswordLogic) ChangePassword(req *types.ChangePasswordRequest) (resp *types.PasswordResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || req.OldPassword == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "old_password and new_password are required")
+	}
+	if l.svcCtx.Revocations == nil {
+		l.Errorf("change password: revocation store not configured")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// Step 2: Read user id and token from context (set by JwtAuth middleware).
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	token, ok := middleware.TokenFromContext(l.ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Change the password.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	if _, err := l.svcCtx.UserService.ChangePassword(ctx, &userpb.ChangePasswordRequest{
+		UserId:      userID,
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	}); err != nil {
+		l.Infof("change password: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 4: Revoke every session, the current one included; the user signs in again.
+	if err := revokeUserSessions(l.ctx, l.svcCtx, userID); err != nil {
+		l.Errorf("change password: set revocation watermark failed: %v", err)
+		return nil, errSessionsNotRevoked
+	}
+	expiry, _ := middleware.TokenExpiryFromContext(l.ctx)
+	if err := blacklistToken(l.ctx, l.svcCtx, token, expiry); err != nil {
+		l.Errorf("change password: set token blacklist failed: %v", err)
+		return nil, errSessionsNotRevoked
+	}
+
+	l.Infof("change password: success, userId=%s", userID)
+	return &types.PasswordResponse{
+		Code: 0,
+		Msg:  "ok",
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ConfirmAvatarLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewConfirmAvatarLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmAvatarLogic {
+	return &ConfirmAvatarLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ConfirmAvatar is ConfirmMedia for the avatar, or for the slot named in the request.
+func (l *ConfirmAvatarLogic) ConfirmAvatar(req *types.ConfirmAvatarRequest) (resp *types.AvatarUrlResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "object_key is required")
+	}
+	mediaResp, err := NewConfirmMediaLogic(l.ctx, l.svcCtx).ConfirmMedia(&types.MediaConfirmRequest{
+		Slot:      avatarRequestSlot(req.Slot),
+		ObjectKey: req.ObjectKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.AvatarUrlResponse{
+		ObjectKey:  mediaResp.ObjectKey,
+		Processing: mediaResp.Processing,
+	}, nil
+}
@@ -0,0 +1,71 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ConfirmMediaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewConfirmMediaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmMediaLogic {
+	return &ConfirmMediaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ConfirmMediaLogic) ConfirmMedia(req *types.MediaConfirmRequest) (resp *types.MediaUploadResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.ObjectKey) == "" {
+		return nil, status.Error(codes.InvalidArgument, "object_key is required")
+	}
+	slot := strings.TrimSpace(req.Slot)
+	if slot == "" {
+		return nil, status.Error(codes.InvalidArgument, "slot is required")
+	}
+
+	// Step 2: Read user id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Ask user-service to check the upload and queue it for processing.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.ConfirmUserMedia(ctx, &userpb.UserMediaRequest{
+		UserId:    userID,
+		Slot:      slot,
+		ObjectKey: strings.TrimSpace(req.ObjectKey),
+	})
+	if err != nil {
+		l.Infof("confirm media: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	return &types.MediaUploadResponse{
+		ObjectKey:  rpcResp.ObjectKey,
+		Processing: rpcResp.Processing,
+	}, nil
+}
@@ -0,0 +1,88 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/pb/authpb"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeactivateAccountLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeactivateAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeactivateAccountLogic {
+	return &DeactivateAccountLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeactivateAccountLogic) DeactivateAccount(req *types.DeactivateAccountRequest) (resp *types.DeactivateAccountResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if l.svcCtx.Revocations == nil {
+		l.Errorf("deactivate account: revocation store not configured")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// Step 2: Read user id and token from context (set by JwtAuth middleware).
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	token, ok := middleware.TokenFromContext(l.ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Sign the user out everywhere before deactivating, so a deactivated account never keeps
+	// a working session. Any failure leaves the account untouched and the request can be retried.
+	if err := revokeUserSessions(l.ctx, l.svcCtx, userID); err != nil {
+		l.Errorf("deactivate account: set revocation watermark failed: %v", err)
+		return nil, errDeactivationNotRevoked
+	}
+	expiry, _ := middleware.TokenExpiryFromContext(l.ctx)
+	if err := blacklistToken(l.ctx, l.svcCtx, token, expiry); err != nil {
+		l.Errorf("deactivate account: set token blacklist failed: %v", err)
+		return nil, errDeactivationNotRevoked
+	}
+	revokeCtx, revokeCancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer revokeCancel()
+	if _, err := l.svcCtx.AuthService.RevokeTokens(revokeCtx, &authpb.RevokeTokensRequest{UserId: userID}); err != nil {
+		l.Errorf("deactivate account: revoke refresh tokens failed: %v", err)
+		return nil, errDeactivationNotRevoked
+	}
+
+	// Step 4: Deactivate the account.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.DeactivateAccount(ctx, &userpb.DeactivateAccountRequest{UserId: userID})
+	if err != nil {
+		l.Errorf("deactivate account: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	l.Infof("deactivate account: success, userId=%s purgeAfter=%s", userID, rpcResp.PurgeAfter)
+	return &types.DeactivateAccountResponse{
+		Code: 0,
+		Data: types.DeactivateAccountResponseData{PurgeAfter: rpcResp.PurgeAfter},
+	}, nil
+}
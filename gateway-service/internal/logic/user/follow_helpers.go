@@ -0,0 +1,22 @@
+package user
+
+import (
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+)
+
+func toFollowList(rpcResp *userpb.FollowListResponse) *types.FollowListResponse {
+	users := make([]types.FollowUser, 0, len(rpcResp.Users))
+	for _, u := range rpcResp.Users {
+		users = append(users, types.FollowUser{
+			UserId:     u.UserId,
+			Nickname:   u.Nickname,
+			Avatar:     u.Avatar,
+			FollowedAt: u.FollowedAt,
+		})
+	}
+	return &types.FollowListResponse{
+		Code: 0,
+		Data: types.FollowListResponseData{Users: users, NextCursor: rpcResp.NextCursor},
+	}
+}
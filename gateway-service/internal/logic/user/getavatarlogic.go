@@ -36,6 +36,9 @@ func (l *GetAvatarLogic) GetAvatar(req *types.AvatarUrlRequest) (resp *types.Ava
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
+	if req.Size < 0 {
+		return nil, status.Error(codes.InvalidArgument, "size must not be negative")
+	}
 
 	// Step 2: Read user id from token subject.
 	subject, ok := middleware.SubjectFromContext(l.ctx)
@@ -49,7 +52,8 @@ func (l *GetAvatarLogic) GetAvatar(req *types.AvatarUrlRequest) (resp *types.Ava
 
 	// Step 3: Build RPC request to user-service.
 	rpcReq := &userpb.UserAvatarRequest{
-		UserId: userID,
+		UserId:     userID,
+		AvatarSize: req.Size,
 	}
 
 	// Step 4: Call user-service GetUserAvatar with timeout.
@@ -0,0 +1,73 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetFollowStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetFollowStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFollowStatusLogic {
+	return &GetFollowStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetFollowStatusLogic) GetFollowStatus(req *types.FollowStatusRequest) (resp *types.FollowResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.UserId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Step 2: Read user id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Build RPC request to user-service.
+	rpcReq := &userpb.FollowRequest{
+		UserId:       userID,
+		TargetUserId: strings.TrimSpace(req.UserId),
+	}
+
+	// Step 4: Call user-service IsFollowing with timeout.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.IsFollowing(ctx, rpcReq)
+	if err != nil {
+		l.Errorf("get follow status: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 5: Map RPC response to HTTP response.
+	return &types.FollowResponse{
+		Code: 0,
+		Data: types.FollowStatus{
+			Following:  rpcResp.Following,
+			FollowedBy: rpcResp.FollowedBy,
+		},
+	}, nil
+}
@@ -0,0 +1,92 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetPublicProfileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPublicProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPublicProfileLogic {
+	return &GetPublicProfileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPublicProfileLogic) GetPublicProfile(req *types.PublicProfileRequest) (resp *types.PublicProfileResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.UserId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Step 2: Read viewer id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	viewerID := strings.TrimSpace(subject)
+	if viewerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Build RPC request to user-service.
+	rpcReq := &userpb.PublicProfileRequest{
+		UserId:   strings.TrimSpace(req.UserId),
+		ViewerId: viewerID,
+	}
+
+	// Step 4: Call user-service GetPublicProfile with timeout.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.GetPublicProfile(ctx, rpcReq)
+	if err != nil {
+		l.Errorf("get public profile: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 5: Map RPC response to HTTP response.
+	profile := types.PublicProfile{
+		UserId:             rpcResp.UserId,
+		Nickname:           rpcResp.Nickname,
+		AvatarUrl:          rpcResp.AvatarUrl,
+		Gender:             rpcResp.Gender,
+		Bio:                rpcResp.Bio,
+		BackgroundImageUrl: rpcResp.BackgroundImageUrl,
+		Country:            rpcResp.Country,
+		Province:           rpcResp.Province,
+		City:               rpcResp.City,
+		School:             rpcResp.School,
+		Major:              rpcResp.Major,
+		CreatedAt:          rpcResp.CreatedAt,
+		Following:          rpcResp.Following,
+		FollowedBy:         rpcResp.FollowedBy,
+		Restricted:         rpcResp.Restricted,
+	}
+	if stats := rpcResp.GetStats(); stats != nil {
+		profile.Stats = &types.UserStats{
+			FollowingCount: stats.FollowingCount,
+			FollowerCount:  stats.FollowerCount,
+			LikesCount:     stats.LikesCount,
+			VideoCount:     stats.VideoCount,
+		}
+	}
+	return &types.PublicProfileResponse{Code: 0, Data: profile}, nil
+}
@@ -66,21 +66,23 @@ func (l *GetUserDataLogic) GetUserData(req *types.UserDataRequest) (resp *types.
 		Code: 0,
 		Data: types.UserDataResponseData{
 			UserInfo: types.UserInfo{
-				Userid:          rpcResp.UserId,
-				Nickname:        rpcResp.Nickname,
-				Avatar:          rpcResp.Avatar,
-				Gender:          rpcResp.Gender,
-				Birthday:        rpcResp.Birthday,
-				Bio:             rpcResp.Bio,
-				BackgroundImage: rpcResp.BackgroundImage,
-				Country:         rpcResp.Country,
-				Province:        rpcResp.Province,
-				City:            rpcResp.City,
-				School:          rpcResp.School,
-				Major:           rpcResp.Major,
-				GraduationYear:  rpcResp.GraduationYear,
-				CreatedAt:       rpcResp.CreatedAt,
-				UpdatedAt:       rpcResp.UpdatedAt,
+				Userid:             rpcResp.UserId,
+				Nickname:           rpcResp.Nickname,
+				Avatar:             rpcResp.Avatar,
+				Gender:             rpcResp.Gender,
+				Birthday:           rpcResp.Birthday,
+				Bio:                rpcResp.Bio,
+				BackgroundImage:    rpcResp.BackgroundImage,
+				AvatarUrl:          rpcResp.AvatarUrl,
+				BackgroundImageUrl: rpcResp.BackgroundImageUrl,
+				Country:            rpcResp.Country,
+				Province:           rpcResp.Province,
+				City:               rpcResp.City,
+				School:             rpcResp.School,
+				Major:              rpcResp.Major,
+				GraduationYear:     rpcResp.GraduationYear,
+				CreatedAt:          rpcResp.CreatedAt,
+				UpdatedAt:          rpcResp.UpdatedAt,
 			},
 		},
 	}, nil
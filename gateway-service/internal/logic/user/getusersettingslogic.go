@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetUserSettingsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetUserSettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserSettingsLogic {
+	return &GetUserSettingsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetUserSettingsLogic) GetUserSettings(req *types.UserSettingsRequest) (resp *types.UserSettingsResponse, err error) {
+	// Step 1: Read user id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 2: Call user-service GetUserSettings with timeout.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.GetUserSettings(ctx, &userpb.UserSettingsRequest{UserId: userID})
+	if err != nil {
+		l.Errorf("get user settings: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 3: Map RPC response to HTTP response.
+	settings, err := toUserSettings(rpcResp.GetSettings())
+	if err != nil {
+		l.Errorf("get user settings: decode notification settings failed: %v", err)
+		return nil, status.Error(codes.Internal, "invalid stored settings")
+	}
+	return &types.UserSettingsResponse{Code: 0, Data: settings}, nil
+}
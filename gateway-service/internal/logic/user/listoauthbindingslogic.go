@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListOAuthBindingsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListOAuthBindingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOAuthBindingsLogic {
+	return &ListOAuthBindingsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListOAuthBindingsLogic) ListOAuthBindings(req *types.OAuthBindingsRequest) (resp *types.OAuthBindingsResponse, err error) {
+	// Step 1: Read user id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 2: Call user-service ListOAuthBindings with timeout.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.ListOAuthBindings(ctx, &userpb.OAuthRequest{UserId: userID})
+	if err != nil {
+		l.Errorf("list oauth bindings: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 3: Map RPC response to HTTP response.
+	return &types.OAuthBindingsResponse{
+		Code: 0,
+		Data: types.OAuthBindingsResponseData{Bindings: toOAuthBindings(rpcResp.Bindings)},
+	}, nil
+}
@@ -0,0 +1,72 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LogoutAllLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLogoutAllLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutAllLogic {
+	return &LogoutAllLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LogoutAllLogic) LogoutAll(req *types.LogoutRequest) (resp *types.LogoutResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if l.svcCtx.Revocations == nil {
+		l.Errorf("logout all: revocation store not configured")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// Step 2: Read user id and token from context (set by JwtAuth middleware).
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	token, ok := middleware.TokenFromContext(l.ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Invalidate every token issued up to now.
+	if err := revokeUserSessions(l.ctx, l.svcCtx, userID); err != nil {
+		l.Errorf("logout all: set revocation watermark failed: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// Step 4: Blacklist the current token as well, since iat has second precision.
+	expiry, _ := middleware.TokenExpiryFromContext(l.ctx)
+	if err := blacklistToken(l.ctx, l.svcCtx, token, expiry); err != nil {
+		l.Errorf("logout all: set token blacklist failed: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	l.Infof("logout all: sessions revoked, userId=%s", userID)
+	return &types.LogoutResponse{
+		Code: 0,
+		Msg:  "ok",
+	}, nil
+}
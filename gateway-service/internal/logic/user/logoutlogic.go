@@ -6,7 +6,6 @@ package user
 import (
 	"context"
 	"strings"
-	"time"
 
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
@@ -17,12 +16,6 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-const (
-	blacklistMinTTL     = time.Minute
-	blacklistDefaultTTL = 24 * time.Hour
-	redisOpTimeout      = 2 * time.Second
-)
-
 type LogoutLogic struct {
 	logx.Logger
 	ctx    context.Context
@@ -42,8 +35,8 @@ func (l *LogoutLogic) Logout(req *types.LogoutRequest) (resp *types.LogoutRespon
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
-	if l.svcCtx.Redis == nil {
-		l.Errorf("logout: redis client not configured")
+	if l.svcCtx.Revocations == nil {
+		l.Errorf("logout: revocation store not configured")
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
@@ -53,28 +46,14 @@ func (l *LogoutLogic) Logout(req *types.LogoutRequest) (resp *types.LogoutRespon
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	// Step 3: Determine blacklist TTL from token expiration.
-	ttl := blacklistDefaultTTL
-	if exp, ok := middleware.TokenExpiryFromContext(l.ctx); ok {
-		ttl = time.Until(exp)
-	}
-	if ttl <= 0 {
-		ttl = blacklistMinTTL
-	}
-	seconds := int(ttl.Seconds())
-	if seconds < 1 {
-		seconds = 1
-	}
-
-	// Step 4: Store token in blacklist.
-	ctx, cancel := context.WithTimeout(l.ctx, redisOpTimeout)
-	defer cancel()
-	if err := l.svcCtx.Redis.SetexCtx(ctx, middleware.TokenBlacklistKey(token), "1", seconds); err != nil {
+	// Step 3: Store token in blacklist until it expires.
+	expiry, _ := middleware.TokenExpiryFromContext(l.ctx)
+	if err := blacklistToken(l.ctx, l.svcCtx, token, expiry); err != nil {
 		l.Errorf("logout: set token blacklist failed: %v", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	// Step 5: Return success response.
+	// Step 4: Return success response.
 	return &types.LogoutResponse{
 		Code: 0,
 		Msg:  "ok",
@@ -0,0 +1,80 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/oauth"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const oauthStateTimeout = 2 * time.Second
+
+// startOAuth records a state for the flow, bound to the browser nonce the handler put in ctx,
+// and returns where to send the user.
+func startOAuth(ctx context.Context, svcCtx *svc.ServiceContext, platform, mode, userID string) (*types.OAuthAuthorizeResponse, error) {
+	provider, err := oauthProvider(svcCtx, platform)
+	if err != nil {
+		return nil, err
+	}
+	nonce, ok := oauth.NonceFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, oauthStateTimeout)
+	defer cancel()
+	state, err := svcCtx.OAuthStates.Create(ctx, oauth.State{Platform: provider.Name(), Mode: mode, UserID: userID}, nonce)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "failed to start oauth flow")
+	}
+
+	return &types.OAuthAuthorizeResponse{
+		Code: 0,
+		Data: types.OAuthAuthorizeResponseData{
+			AuthorizeUrl: provider.AuthCodeURL(state),
+			State:        state,
+		},
+	}, nil
+}
+
+func oauthProvider(svcCtx *svc.ServiceContext, platform string) (oauth.Provider, error) {
+	provider, ok := svcCtx.OAuth.Get(strings.ToLower(strings.TrimSpace(platform)))
+	if !ok {
+		return nil, status.Error(codes.NotFound, "oauth platform not supported")
+	}
+	return provider, nil
+}
+
+func toRPCIdentity(platform string, identity *oauth.Identity) *userpb.OAuthIdentity {
+	return &userpb.OAuthIdentity{
+		Platform:       platform,
+		Openid:         identity.OpenID,
+		Unionid:        identity.UnionID,
+		Nickname:       identity.Nickname,
+		Avatar:         identity.Avatar,
+		AccessToken:    identity.AccessToken,
+		RefreshToken:   identity.RefreshToken,
+		TokenExpiresAt: identity.ExpiresAt,
+		RawData:        identity.RawData,
+	}
+}
+
+func toOAuthBindings(bindings []*userpb.OAuthBinding) []types.OAuthBinding {
+	out := make([]types.OAuthBinding, 0, len(bindings))
+	for _, b := range bindings {
+		out = append(out, types.OAuthBinding{
+			Platform:  b.Platform,
+			Nickname:  b.Nickname,
+			Avatar:    b.Avatar,
+			CreatedAt: b.CreatedAt,
+		})
+	}
+	return out
+}
@@ -0,0 +1,40 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/oauth"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OAuthAuthorizeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewOAuthAuthorizeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OAuthAuthorizeLogic {
+	return &OAuthAuthorizeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *OAuthAuthorizeLogic) OAuthAuthorize(req *types.OAuthAuthorizeRequest) (resp *types.OAuthAuthorizeResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || req.Platform == "" {
+		return nil, status.Error(codes.InvalidArgument, "platform is required")
+	}
+
+	// Step 2: Record a login state and build the provider URL.
+	return startOAuth(l.ctx, l.svcCtx, req.Platform, oauth.ModeLogin, "")
+}
@@ -0,0 +1,117 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/oauth"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/pb/authpb"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OAuthCallbackLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewOAuthCallbackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OAuthCallbackLogic {
+	return &OAuthCallbackLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *OAuthCallbackLogic) OAuthCallback(req *types.OAuthCallbackRequest) (resp *types.OAuthCallbackResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || req.Code == "" || req.State == "" {
+		return nil, status.Error(codes.InvalidArgument, "code and state are required")
+	}
+	provider, err := oauthProvider(l.svcCtx, req.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Consume the state; it must have been issued for this platform, to this browser.
+	stateCtx, stateCancel := context.WithTimeout(l.ctx, oauthStateTimeout)
+	defer stateCancel()
+	nonce, _ := oauth.NonceFromContext(l.ctx)
+	state, err := l.svcCtx.OAuthStates.Consume(stateCtx, req.State, provider.Name(), nonce)
+	switch {
+	case err == nil:
+	case errors.Is(err, oauth.ErrStateNotFound):
+		return nil, status.Error(codes.FailedPrecondition, "oauth state expired or already used")
+	case errors.Is(err, oauth.ErrStatePlatform):
+		return nil, status.Error(codes.FailedPrecondition, "oauth state does not match platform")
+	case errors.Is(err, oauth.ErrStateBrowser):
+		l.Infof("oauth callback: state used from another browser, platform=%s", provider.Name())
+		return nil, status.Error(codes.FailedPrecondition, "oauth state was not issued to this browser")
+	default:
+		l.Errorf("oauth callback: load state failed: %v", err)
+		return nil, status.Error(codes.Unavailable, "failed to load oauth state")
+	}
+
+	// Step 3: Exchange the code for the provider identity.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	identity, err := provider.Exchange(ctx, req.Code)
+	if err != nil {
+		l.Infof("oauth callback: exchange failed, platform=%s: %v", provider.Name(), err)
+		return nil, status.Error(codes.Unauthenticated, "oauth authorization failed")
+	}
+	rpcIdentity := toRPCIdentity(provider.Name(), identity)
+
+	// Step 4: Bind to the user who started the flow, or log in.
+	if state.Mode == oauth.ModeBind {
+		rpcResp, err := l.svcCtx.UserService.BindOAuth(ctx, &userpb.OAuthRequest{
+			UserId:   state.UserID,
+			Identity: rpcIdentity,
+		})
+		if err != nil {
+			l.Errorf("oauth callback: bind rpc call failed: %v", err)
+			return nil, err
+		}
+		return &types.OAuthCallbackResponse{
+			Code: 0,
+			Data: types.OAuthCallbackResponseData{
+				Mode:     oauth.ModeBind,
+				Bindings: toOAuthBindings(rpcResp.Bindings),
+			},
+		}, nil
+	}
+
+	account, err := l.svcCtx.UserService.OAuthLogin(ctx, &userpb.OAuthRequest{Identity: rpcIdentity})
+	if err != nil {
+		l.Errorf("oauth callback: login rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 5: Issue tokens for the resolved account.
+	tokens, err := l.svcCtx.AuthService.IssueToken(ctx, &authpb.IssueTokenRequest{
+		UserId: account.UserId,
+	})
+	if err != nil {
+		l.Errorf("oauth callback: issue token rpc call failed: %v", err)
+		return nil, err
+	}
+
+	return &types.OAuthCallbackResponse{
+		Code: 0,
+		Data: types.OAuthCallbackResponseData{
+			Mode:         oauth.ModeLogin,
+			AccessToken:  tokens.AccessToken,
+			RefreshToken: tokens.RefreshToken,
+			Created:      account.Created,
+		},
+	}, nil
+}
@@ -0,0 +1,69 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/pb/authpb"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReactivateAccountLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReactivateAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReactivateAccountLogic {
+	return &ReactivateAccountLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReactivateAccountLogic) ReactivateAccount(req *types.ReactivateAccountRequest) (resp *types.LoginResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.Username) == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "username and password are required")
+	}
+
+	// Step 2: Restore the account; user-service checks the password and the grace period.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	account, err := l.svcCtx.UserService.ReactivateAccount(ctx, &userpb.ReactivateAccountRequest{
+		Username: strings.TrimSpace(req.Username),
+		Password: req.Password,
+	})
+	if err != nil {
+		l.Infof("reactivate account: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 3: Sign the user in, as a successful login would.
+	tokens, err := l.svcCtx.AuthService.IssueToken(ctx, &authpb.IssueTokenRequest{
+		UserId: account.UserId,
+	})
+	if err != nil {
+		l.Errorf("reactivate account: issue token rpc call failed: %v", err)
+		return nil, err
+	}
+
+	l.Infof("reactivate account: success, userId=%s", account.UserId)
+	return &types.LoginResponse{
+		Code: 0,
+		Data: types.LoginResponseData{
+			AccessToken:  tokens.AccessToken,
+			RefreshToken: tokens.RefreshToken,
+		},
+	}, nil
+}
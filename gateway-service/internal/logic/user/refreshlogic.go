@@ -0,0 +1,92 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/pb/authpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RefreshLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRefreshLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshLogic {
+	return &RefreshLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RefreshLogic) Refresh(req *types.RefreshRequest) (resp *types.RefreshResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.RefreshToken) == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	// Step 2: Reject refresh tokens issued before a "log out everywhere".
+	if l.svcCtx.Revocations == nil {
+		l.Errorf("refresh: revocation store not configured")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	revoked, err := isSessionRevoked(l.ctx, l.svcCtx, strings.TrimSpace(req.RefreshToken))
+	if err != nil {
+		l.Errorf("refresh: redis revocation check failed: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if revoked {
+		l.Infof("refresh: refresh token issued before session revocation")
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Blacklist the presented access token, if any, so it cannot outlive the rotation.
+	// It must belong to the refresh token's user, otherwise a caller could revoke someone else's
+	// token. This runs before the rotation so a failure leaves the old refresh token usable.
+	if accessToken, err := middleware.ParseBearerToken(req.Authorization); err == nil && accessToken != "" {
+		if unverifiedSubject(accessToken) != unverifiedSubject(strings.TrimSpace(req.RefreshToken)) {
+			l.Infof("refresh: access token subject does not match refresh token")
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		if err := blacklistToken(l.ctx, l.svcCtx, accessToken, unverifiedExpiry(accessToken)); err != nil {
+			l.Errorf("refresh: set token blacklist failed: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	// Step 4: Call auth-service RefreshToken with timeout.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.AuthService.RefreshToken(ctx, &authpb.RefreshTokenRequest{
+		RefreshToken: strings.TrimSpace(req.RefreshToken),
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			l.Infof("refresh: refresh token rejected: %v", err)
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		l.Errorf("refresh: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 5: Map RPC response to HTTP response.
+	return &types.RefreshResponse{
+		Code: 0,
+		Data: types.LoginResponseData{
+			AccessToken:  rpcResp.AccessToken,
+			RefreshToken: rpcResp.RefreshToken,
+		},
+	}, nil
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const rpcCallTimeout = 5 * time.Second
@@ -34,7 +36,7 @@ func NewRegisterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Register
 func (l *RegisterLogic) Register(req *types.RegisterRequest) (resp *types.RegisterResponse, err error) {
 	// Step 1: Validate request payload.
 	if req == nil || req.Username == "" || req.Password == "" {
-		return &types.RegisterResponse{Code: httpstatuscode.CodeInvalidParam}, nil
+		return nil, status.Error(codes.InvalidArgument, "invalid params")
 	}
 
 	// Step 2: Build RPC request to user-service.
@@ -50,24 +52,21 @@ func (l *RegisterLogic) Register(req *types.RegisterRequest) (resp *types.Regist
 	rpcResp, err := l.svcCtx.UserService.Register(ctx, rpcReq)
 	if err != nil {
 		l.Errorf("register: rpc call failed: %v", err)
-		return &types.RegisterResponse{Code: httpstatuscode.CodeInternalError}, nil
+		return nil, err
 	}
 
-	// Step 4: Map RPC response to HTTP response.
-	var msg string
+	// Step 4: Map legacy in-body codes from user-service builds that predate status errors.
 	switch rpcResp.Code {
 	case 0:
-		msg = "注册成功"
+		return &types.RegisterResponse{
+			Code: httpstatuscode.CodeSuccess,
+			Msg:  "注册成功"}, nil
 	case 1:
-		msg = "参数无效"
+		return nil, status.Error(codes.InvalidArgument, "invalid params")
 	case 2:
-		msg = "用户名已存在"
-	case 3:
-		msg = "内部错误"
+		return nil, status.Error(codes.AlreadyExists, "username already exists")
 	default:
-		msg = "注册失败"
+		l.Errorf("register: user-service returned code=%d", rpcResp.Code)
+		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &types.RegisterResponse{
-		Code: rpcResp.Code,
-		Msg:  msg}, nil
 }
@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RequestPasswordResetLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRequestPasswordResetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestPasswordResetLogic {
+	return &RequestPasswordResetLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RequestPasswordResetLogic) RequestPasswordReset(req *types.PasswordResetRequest) (resp *types.PasswordResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.Login) == "" {
+		return nil, status.Error(codes.InvalidArgument, "login is required")
+	}
+
+	// Step 2: Ask user-service to send a token. The answer is the same whether or not the
+	// account exists.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	if _, err := l.svcCtx.UserService.RequestPasswordReset(ctx, &userpb.PasswordResetRequest{
+		Login: strings.TrimSpace(req.Login),
+	}); err != nil {
+		l.Errorf("request password reset: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	return &types.PasswordResponse{
+		Code: 0,
+		Msg:  "ok",
+	}, nil
+}
@@ -0,0 +1,66 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ResetPasswordLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetPasswordLogic {
+	return &ResetPasswordLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ResetPasswordLogic) ResetPassword(req *types.ResetPasswordRequest) (resp *types.PasswordResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil || strings.TrimSpace(req.Token) == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and new_password are required")
+	}
+	if l.svcCtx.Revocations == nil {
+		l.Errorf("reset password: revocation store not configured")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// Step 2: Set the new password with the token.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.ResetPassword(ctx, &userpb.ResetPasswordRequest{
+		Token:       strings.TrimSpace(req.Token),
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		l.Infof("reset password: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 3: Revoke every session of the account.
+	if err := revokeUserSessions(l.ctx, l.svcCtx, rpcResp.UserId); err != nil {
+		l.Errorf("reset password: set revocation watermark failed: %v", err)
+		return nil, errSessionsNotRevoked
+	}
+
+	l.Infof("reset password: success, userId=%s", rpcResp.UserId)
+	return &types.PasswordResponse{
+		Code: 0,
+		Msg:  "ok",
+	}, nil
+}
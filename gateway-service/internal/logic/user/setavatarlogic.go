@@ -7,10 +7,8 @@ import (
 	"context"
 	"strings"
 
-	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
-	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -31,38 +29,30 @@ func NewSetAvatarLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetAvat
 	}
 }
 
-func (l *SetAvatarLogic) SetAvatar(req *types.AvatarUrlRequest) (resp *types.AvatarUrlResponse, err error) {
-	// Step 1: Validate request parameters.
+// SetAvatar is SetMedia for the avatar, or for the slot named in the request.
+func (l *SetAvatarLogic) SetAvatar(req *types.AvatarUploadRequest) (resp *types.AvatarUrlResponse, err error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
-
-	// Step 2: Read user id from token subject.
-	subject, ok := middleware.SubjectFromContext(l.ctx)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "unauthorized")
-	}
-	userID := strings.TrimSpace(subject)
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "unauthorized")
-	}
-
-	// Step 3: Build RPC request to user-service.
-	rpcReq := &userpb.UserAvatarRequest{
-		UserId: userID,
-	}
-
-	// Step 4: Call user-service SetUserAvatar with timeout.
-	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
-	defer cancel()
-	rpcResp, err := l.svcCtx.UserService.SetUserAvatar(ctx, rpcReq)
+	mediaResp, err := NewSetMediaLogic(l.ctx, l.svcCtx).SetMedia(&types.MediaUploadRequest{
+		Slot:        avatarRequestSlot(req.Slot),
+		ContentType: req.ContentType,
+		Size:        req.Size,
+	})
 	if err != nil {
-		l.Errorf("set avatar: rpc call failed: %v", err)
 		return nil, err
 	}
-
-	// Step 5: Map RPC response to HTTP response.
 	return &types.AvatarUrlResponse{
-		AvatarUrl: rpcResp.AvatarUrl,
+		AvatarUrl:  mediaResp.UploadUrl,
+		ObjectKey:  mediaResp.ObjectKey,
+		FormFields: mediaResp.FormFields,
 	}, nil
 }
+
+// avatarRequestSlot is the slot an avatar request works on: the avatar unless it names another.
+func avatarRequestSlot(slot string) string {
+	if slot = strings.TrimSpace(slot); slot != "" {
+		return slot
+	}
+	return "avatar"
+}
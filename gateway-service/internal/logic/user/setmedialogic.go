@@ -0,0 +1,81 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetMediaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSetMediaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetMediaLogic {
+	return &SetMediaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetMediaLogic) SetMedia(req *types.MediaUploadRequest) (resp *types.MediaUploadResponse, err error) {
+	// Step 1: Validate request parameters. Slots, allowed types and sizes are checked by user-service.
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	slot := strings.TrimSpace(req.Slot)
+	if slot == "" {
+		return nil, status.Error(codes.InvalidArgument, "slot is required")
+	}
+	contentType := strings.TrimSpace(req.ContentType)
+	if contentType == "" {
+		return nil, status.Error(codes.InvalidArgument, "content_type is required")
+	}
+	if req.Size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size must be positive")
+	}
+
+	// Step 2: Read user id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Call user-service SetUserMedia with timeout.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.SetUserMedia(ctx, &userpb.UserMediaRequest{
+		UserId:      userID,
+		Slot:        slot,
+		ContentType: contentType,
+		Size:        req.Size,
+	})
+	if err != nil {
+		l.Errorf("set media: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 4: Map RPC response to HTTP response.
+	return &types.MediaUploadResponse{
+		UploadUrl:  rpcResp.UploadUrl,
+		ObjectKey:  rpcResp.ObjectKey,
+		FormFields: rpcResp.FormFields,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"encoding/json"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+)
+
+// toUserSettings maps the RPC settings to the HTTP shape, decoding the notification JSON.
+func toUserSettings(s *userpb.UserSettings) (types.UserSettings, error) {
+	if s == nil {
+		return types.UserSettings{}, nil
+	}
+	out := types.UserSettings{
+		PrivacyLevel:     s.PrivacyLevel,
+		AllowStrangerMsg: s.AllowStrangerMsg,
+		ShowOnlineStatus: s.ShowOnlineStatus,
+		ShowLikes:        s.ShowLikes,
+		ShowFollowing:    s.ShowFollowing,
+		ShowFollowers:    s.ShowFollowers,
+		Language:         s.Language,
+		Timezone:         s.Timezone,
+		Theme:            s.Theme,
+		UpdatedAt:        s.UpdatedAt,
+	}
+	if s.NotificationSettings != "" {
+		var notifications types.NotificationSettings
+		if err := json.Unmarshal([]byte(s.NotificationSettings), &notifications); err != nil {
+			return out, err
+		}
+		out.NotificationSettings = &notifications
+	}
+	return out, nil
+}
+
+// toRPCSettings maps a PATCH body to the RPC request. Nil notification fields are sent as JSON
+// null, which user-service treats as unchanged.
+func toRPCSettings(s *types.UserSettings) (*userpb.UserSettings, error) {
+	out := &userpb.UserSettings{
+		PrivacyLevel:     s.PrivacyLevel,
+		AllowStrangerMsg: s.AllowStrangerMsg,
+		ShowOnlineStatus: s.ShowOnlineStatus,
+		ShowLikes:        s.ShowLikes,
+		ShowFollowing:    s.ShowFollowing,
+		ShowFollowers:    s.ShowFollowers,
+		Language:         s.Language,
+		Timezone:         s.Timezone,
+		Theme:            s.Theme,
+	}
+	if s.NotificationSettings != nil {
+		raw, err := json.Marshal(s.NotificationSettings)
+		if err != nil {
+			return nil, err
+		}
+		out.NotificationSettings = string(raw)
+	}
+	return out, nil
+}
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	blacklistMinTTL     = time.Minute
+	blacklistDefaultTTL = 24 * time.Hour
+)
+
+// errSessionsNotRevoked is returned when a password changed but the old sessions may still work.
+var errSessionsNotRevoked = status.Error(codes.Internal, "password changed, but signing out existing sessions failed")
+
+// errDeactivationNotRevoked is returned when sessions could not be revoked, so the account was not deactivated.
+var errDeactivationNotRevoked = status.Error(codes.Unavailable, "signing out existing sessions failed, account not deactivated")
+
+// blacklistToken revokes the token until the token would expire on its own.
+// A zero expiry falls back to blacklistDefaultTTL.
+func blacklistToken(ctx context.Context, svcCtx *svc.ServiceContext, token string, expiry time.Time) error {
+	ttl := blacklistDefaultTTL
+	if !expiry.IsZero() {
+		ttl = time.Until(expiry)
+	}
+	if ttl <= 0 {
+		ttl = blacklistMinTTL
+	}
+	return svcCtx.Revocations.Blacklist(ctx, token, ttl)
+}
+
+// unverifiedExpiry reads the exp claim without checking the signature.
+// It is only used to bound how long a presented token stays blacklisted.
+func unverifiedExpiry(token string) time.Time {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return time.Time{}
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return time.Time{}
+	}
+	if exp.Time.After(time.Now().Add(blacklistDefaultTTL)) {
+		return time.Now().Add(blacklistDefaultTTL)
+	}
+	return exp.Time
+}
+
+// unverifiedSubject reads the sub claim without checking the signature, or "" if there is none.
+func unverifiedSubject(token string) string {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return ""
+	}
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return ""
+	}
+	return subject
+}
+
+// revokeUserSessions records a watermark so that every token of userID issued up to now is rejected.
+func revokeUserSessions(ctx context.Context, svcCtx *svc.ServiceContext, userID string) error {
+	ttl := time.Duration(svcCtx.Config.JwtAuth.RevokeSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = blacklistDefaultTTL
+	}
+	return svcCtx.Revocations.RevokeSessions(ctx, userID, ttl)
+}
+
+// isSessionRevoked checks an unverified token against its subject's revocation watermark.
+// Tokens without a subject are left for the issuer to reject.
+func isSessionRevoked(ctx context.Context, svcCtx *svc.ServiceContext, token string) (bool, error) {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return false, nil
+	}
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return false, nil
+	}
+
+	return svcCtx.Revocations.IsRevoked(ctx, subject, middleware.IssuedAtMillis(claims))
+}
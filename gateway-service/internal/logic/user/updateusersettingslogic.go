@@ -0,0 +1,76 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/types"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UpdateUserSettingsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateUserSettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateUserSettingsLogic {
+	return &UpdateUserSettingsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateUserSettingsLogic) UpdateUserSettings(req *types.UserSettings) (resp *types.UserSettingsResponse, err error) {
+	// Step 1: Validate request parameters.
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	// Step 2: Read user id from token subject.
+	subject, ok := middleware.SubjectFromContext(l.ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	userID := strings.TrimSpace(subject)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// Step 3: Build RPC request to user-service.
+	settings, err := toRPCSettings(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid notification_settings")
+	}
+	rpcReq := &userpb.UserSettingsRequest{
+		UserId:   userID,
+		Settings: settings,
+	}
+
+	// Step 4: Call user-service UpdateUserSettings with timeout.
+	ctx, cancel := context.WithTimeout(l.ctx, rpcCallTimeout)
+	defer cancel()
+	rpcResp, err := l.svcCtx.UserService.UpdateUserSettings(ctx, rpcReq)
+	if err != nil {
+		l.Errorf("update user settings: rpc call failed: %v", err)
+		return nil, err
+	}
+
+	// Step 5: Map RPC response to HTTP response.
+	updated, err := toUserSettings(rpcResp.GetSettings())
+	if err != nil {
+		l.Errorf("update user settings: decode notification settings failed: %v", err)
+		return nil, status.Error(codes.Internal, "invalid stored settings")
+	}
+	return &types.UserSettingsResponse{Code: 0, Data: updated}, nil
+}
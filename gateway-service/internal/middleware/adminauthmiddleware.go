@@ -0,0 +1,16 @@
+package middleware
+
+// RoleAdmin is the role claim required by route groups declaring the AdminAuth middleware.
+const RoleAdmin = "admin"
+
+// AdminAuthMiddleware restricts a route group to tokens carrying the admin role.
+// Declare it in user.api as `middleware: JwtAuth, AdminAuth`.
+type AdminAuthMiddleware struct {
+	*RoleAuthMiddleware
+}
+
+func NewAdminAuthMiddleware() *AdminAuthMiddleware {
+	return &AdminAuthMiddleware{
+		RoleAuthMiddleware: NewRoleAuthMiddleware([]string{RoleAdmin}),
+	}
+}
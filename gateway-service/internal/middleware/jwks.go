@@ -0,0 +1,314 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/config"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/pb/authpb"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/syncx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+const (
+	jwksFetchTimeout      = 3 * time.Second
+	jwksDefaultTTL        = 5 * time.Minute
+	jwksDefaultRefetchGap = 10 * time.Second
+	jwksFlightKey         = "jwks"
+)
+
+var errUnknownKid = errors.New("unknown kid")
+
+// supportedSigningMethods lists every alg the gateway accepts; the key type is checked per token.
+var supportedSigningMethods = []string{
+	jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS384.Alg(), jwt.SigningMethodRS512.Alg(),
+	jwt.SigningMethodPS256.Alg(), jwt.SigningMethodPS384.Alg(), jwt.SigningMethodPS512.Alg(),
+	jwt.SigningMethodES256.Alg(), jwt.SigningMethodES384.Alg(), jwt.SigningMethodES512.Alg(),
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
+// verificationKey holds a parsed public key with its kid and optional pinned alg.
+type verificationKey struct {
+	Kid       string
+	Alg       string
+	PublicKey any
+}
+
+// jwksCache keeps the last good JWK set in memory.
+// A background goroutine refreshes it every CacheSeconds; failed refreshes keep serving
+// the previous set (stale-while-revalidate). An unknown kid triggers an immediate refetch,
+// at most once per RefetchSeconds, so a rotated key is picked up without waiting for the TTL.
+type jwksCache struct {
+	authService authpb.AuthServiceClient
+	ttl         time.Duration
+	refetchGap  time.Duration
+	flight      syncx.SingleFlight
+
+	mu          sync.RWMutex
+	keys        map[string]verificationKey
+	lastAttempt time.Time
+}
+
+func newJwksCache(cfg config.JwtAuthConf, authService authpb.AuthServiceClient) *jwksCache {
+	ttl := time.Duration(cfg.CacheSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = jwksDefaultTTL
+	}
+	refetchGap := time.Duration(cfg.RefetchSeconds) * time.Second
+	if refetchGap <= 0 {
+		refetchGap = jwksDefaultRefetchGap
+	}
+
+	return &jwksCache{
+		authService: authService,
+		ttl:         ttl,
+		refetchGap:  refetchGap,
+		flight:      syncx.NewSingleFlight(),
+	}
+}
+
+// start launches the background refresher. The first fetch happens immediately.
+func (c *jwksCache) start() {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(c.ttl)
+		defer ticker.Stop()
+
+		for {
+			if err := c.refresh(context.Background()); err != nil {
+				logx.Errorf("jwks: background refresh failed, serving last good key set: %v", err)
+			}
+			<-ticker.C
+		}
+	})
+}
+
+// lookup returns the key for kid, refetching once if it is unknown and the refetch gap allows it.
+func (c *jwksCache) lookup(ctx context.Context, kid string) (verificationKey, error) {
+	if key, ok := c.get(kid); ok {
+		return key, nil
+	}
+	if !c.allowRefetch() {
+		return verificationKey{}, errUnknownKid
+	}
+	if err := c.refresh(ctx); err != nil {
+		if c.empty() {
+			return verificationKey{}, err
+		}
+		logx.WithContext(ctx).Errorf("jwks: refetch for unknown kid failed: %v", err)
+	}
+	if key, ok := c.get(kid); ok {
+		return key, nil
+	}
+	return verificationKey{}, errUnknownKid
+}
+
+// refresh fetches the JWK set from auth-service. Concurrent callers share one in-flight request.
+func (c *jwksCache) refresh(ctx context.Context) error {
+	_, err := c.flight.Do(jwksFlightKey, func() (any, error) {
+		c.mu.Lock()
+		c.lastAttempt = time.Now()
+		c.mu.Unlock()
+
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), jwksFetchTimeout)
+		defer cancel()
+		resp, err := c.authService.GetJwks(fetchCtx, &authpb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+		keys, err := parseJwksResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.keys = keys
+		c.mu.Unlock()
+		return nil, nil
+	})
+	return err
+}
+
+func (c *jwksCache) get(kid string) (verificationKey, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	key, ok := c.keys[kid]
+	return key, ok
+}
+
+func (c *jwksCache) empty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.keys) == 0
+}
+
+func (c *jwksCache) allowRefetch() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return time.Since(c.lastAttempt) >= c.refetchGap
+}
+
+// keyFunc resolves the verification key for a token and checks that alg matches the key type.
+func (c *jwksCache) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("missing kid")
+		}
+		key, err := c.lookup(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		alg := token.Method.Alg()
+		if key.Alg != "" && key.Alg != alg {
+			return nil, fmt.Errorf("alg %s does not match jwk alg %s", alg, key.Alg)
+		}
+		if !algMatchesKey(alg, key.PublicKey) {
+			return nil, fmt.Errorf("alg %s does not match key type", alg)
+		}
+		return key.PublicKey, nil
+	}
+}
+
+func algMatchesKey(alg string, key any) bool {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		switch alg {
+		case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512":
+			return true
+		}
+	case *ecdsa.PublicKey:
+		switch alg {
+		case "ES256":
+			return k.Curve == elliptic.P256()
+		case "ES384":
+			return k.Curve == elliptic.P384()
+		case "ES512":
+			return k.Curve == elliptic.P521()
+		}
+	case ed25519.PublicKey:
+		return alg == jwt.SigningMethodEdDSA.Alg()
+	}
+	return false
+}
+
+// parseJwksResponse converts the gRPC JwksResponse into verification keys indexed by kid.
+// Keys of unsupported types or with a use other than "sig" are skipped, and so are malformed keys,
+// which are logged. It fails only when no usable key is left.
+func parseJwksResponse(resp *authpb.JwksResponse) (map[string]verificationKey, error) {
+	if resp == nil || len(resp.Keys) == 0 {
+		return nil, errors.New("empty jwks response")
+	}
+
+	keys := make(map[string]verificationKey, len(resp.Keys))
+	for _, k := range resp.Keys {
+		if k.Kid == "" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		var (
+			pubKey any
+			err    error
+		)
+		switch k.Kty {
+		case "RSA":
+			pubKey, err = parseRSAJwk(k)
+		case "EC":
+			pubKey, err = parseECJwk(k)
+		case "OKP":
+			pubKey, err = parseOKPJwk(k)
+		default:
+			continue
+		}
+		if err != nil {
+			// One malformed key must not take down verification with every other key.
+			logx.Errorf("jwks: skipping invalid key %s: %v", k.Kid, err)
+			continue
+		}
+
+		keys[k.Kid] = verificationKey{
+			Kid:       k.Kid,
+			Alg:       k.Alg,
+			PublicKey: pubKey,
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no supported keys in jwks response")
+	}
+	return keys, nil
+}
+
+func parseRSAJwk(k *authpb.Jwk) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil || len(nBytes) == 0 {
+		return nil, errors.New("failed to decode n")
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil || len(eBytes) == 0 {
+		return nil, errors.New("failed to decode e")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: int(new(big.Int).SetBytes(eBytes).Int64()),
+	}, nil
+}
+
+func parseECJwk(k *authpb.Jwk) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+
+	xBytes, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, errors.New("failed to decode x")
+	}
+	yBytes, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, errors.New("failed to decode y")
+	}
+	size := (curve.Params().BitSize + 7) / 8
+	if len(xBytes) != size || len(yBytes) != size {
+		return nil, errors.New("invalid coordinate length")
+	}
+
+	point := make([]byte, 0, 1+2*size)
+	point = append(point, 0x04)
+	point = append(point, xBytes...)
+	point = append(point, yBytes...)
+	return ecdsa.ParseUncompressedPublicKey(curve, point)
+}
+
+func parseOKPJwk(k *authpb.Jwk) (ed25519.PublicKey, error) {
+	if k.Crv != "Ed25519" {
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+	xBytes, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, errors.New("failed to decode x")
+	}
+	if len(xBytes) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid Ed25519 key length")
+	}
+	return ed25519.PublicKey(xBytes), nil
+}
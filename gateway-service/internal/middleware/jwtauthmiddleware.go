@@ -5,15 +5,13 @@ package middleware
 
 import (
 	"context"
-	"crypto/rsa"
 	"crypto/sha256"
-	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
-	"math/big"
 	"net/http"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/config"
@@ -21,23 +19,15 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/zeromicro/go-zero/core/logx"
-	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest/httpx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// rsaPublicKey holds a parsed RSA public key with its kid.
-type rsaPublicKey struct {
-	Kid       string
-	PublicKey *rsa.PublicKey
-}
-
 type JwtAuthMiddleware struct {
 	cfg         config.JwtAuthConf
-	authService authpb.AuthServiceClient
-	redis       *redis.Redis
-	mu          sync.RWMutex
-	keys        []rsaPublicKey
-	fetchedAt   time.Time
+	jwks        *jwksCache
+	revocations *RevocationStore
 }
 
 type ctxKey int
@@ -46,19 +36,29 @@ const (
 	ctxKeySubject ctxKey = iota
 	ctxKeyToken
 	ctxKeyTokenExpiry
+	ctxKeyRoles
 )
 
 const (
 	tokenBlacklistPrefix = "gateway:token:blacklist:"
 	tokenBlacklistValue  = "1"
+	tokenRevokedPrefix   = "gateway:token:revoked-before:"
 	redisOpTimeout       = 2 * time.Second
+
+	// claimIssuedAtMillis is auth-service's millisecond issue time; iat only has second precision.
+	claimIssuedAtMillis = "iat_ms"
+	// Watermarks below this value were written in unix seconds by older gateways.
+	legacyWatermarkLimit = 100_000_000_000
 )
 
-func NewJwtAuthMiddleware(cfg config.JwtAuthConf, authService authpb.AuthServiceClient, redisClient *redis.Redis) *JwtAuthMiddleware {
+func NewJwtAuthMiddleware(cfg config.JwtAuthConf, authService authpb.AuthServiceClient, revocations *RevocationStore) *JwtAuthMiddleware {
+	jwks := newJwksCache(cfg, authService)
+	jwks.start()
+
 	return &JwtAuthMiddleware{
 		cfg:         cfg,
-		authService: authService,
-		redis:       redisClient,
+		jwks:        jwks,
+		revocations: revocations,
 	}
 }
 
@@ -70,78 +70,75 @@ func (m *JwtAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 		tokenStr, err := bearerToken(r)
 		if err != nil {
 			logger.Infof("jwt auth: %v", err)
-			writeUnauthorized(w)
+			writeUnauthorized(w, r)
 			return
 		}
 
 		// Step 2: Reject tokens that are in blacklist.
-		if m.redis == nil {
-			logger.Errorf("jwt auth: redis client not configured")
-			writeUnauthorized(w)
+		if m.revocations == nil {
+			logger.Errorf("jwt auth: revocation store not configured")
+			writeUnauthorized(w, r)
 			return
 		}
-		blacklisted, err := m.isTokenBlacklisted(r.Context(), tokenStr)
+		blacklisted, err := m.revocations.IsBlacklisted(r.Context(), tokenStr)
 		if err != nil {
 			logger.Errorf("jwt auth: redis blacklist check failed: %v", err)
-			writeUnauthorized(w)
-			return
+			if !m.revocations.FailOpen() {
+				writeUnauthorized(w, r)
+				return
+			}
 		}
 		if blacklisted {
 			logger.Infof("jwt auth: token is blacklisted")
-			writeUnauthorized(w)
-			return
-		}
-
-		// Step 3: Load JWK set via gRPC (cached with TTL).
-		keys, err := m.getKeys(r.Context())
-		if err != nil {
-			logger.Errorf("jwt auth: failed to fetch jwks via gRPC: %v", err)
-			writeUnauthorized(w)
+			writeUnauthorized(w, r)
 			return
 		}
 
-		// Step 4: Parse and validate token signature + claims.
+		// Step 3: Parse and validate token signature + claims.
+		// Keys come from the in-memory JWK set kept fresh by a background refresher.
 		claims := jwt.MapClaims{}
-		_, err = jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (any, error) {
-			if token.Method.Alg() != jwt.SigningMethodRS256.Alg() {
-				return nil, errors.New("invalid signing method")
-			}
-			kid, _ := token.Header["kid"].(string)
-			if kid == "" {
-				return nil, errors.New("missing kid")
-			}
-			for _, k := range keys {
-				if k.Kid == kid {
-					return k.PublicKey, nil
-				}
-			}
-			return nil, errors.New("unknown kid")
-		}, jwt.WithIssuer(m.cfg.Issuer))
+		_, err = jwt.ParseWithClaims(tokenStr, claims, m.jwks.keyFunc(r.Context()),
+			jwt.WithIssuer(m.cfg.Issuer), jwt.WithValidMethods(supportedSigningMethods))
 		if err != nil {
 			logger.Infof("jwt auth: token validation failed: %v", err)
-			writeUnauthorized(w)
+			writeUnauthorized(w, r)
 			return
 		}
 
 		expiration, err := claims.GetExpirationTime()
 		if err != nil || expiration == nil {
 			logger.Infof("jwt auth: missing exp claim")
-			writeUnauthorized(w)
+			writeUnauthorized(w, r)
 			return
 		}
 
 		subject, err := claims.GetSubject()
 		if err != nil || strings.TrimSpace(subject) == "" {
 			logger.Infof("jwt auth: missing subject in token")
-			writeUnauthorized(w)
+			writeUnauthorized(w, r)
 			return
 		}
 
-		// Step 5: Enforce access token type (must be present and equal "access").
+		// Step 4: Enforce access token type (must be present and equal "access").
 		tokenType, ok := claims["token_type"].(string)
 		if !ok || tokenType != "access" {
 			logger.Infof("jwt auth: invalid or missing token_type: %v", claims["token_type"])
-			writeUnauthorized(w)
+			writeUnauthorized(w, r)
+			return
+		}
+
+		// Step 5: Reject tokens issued before the user's "log out everywhere" watermark.
+		revoked, err := m.revocations.IsRevoked(r.Context(), subject, IssuedAtMillis(claims))
+		if err != nil {
+			logger.Errorf("jwt auth: redis revocation check failed: %v", err)
+			if !m.revocations.FailOpen() {
+				writeUnauthorized(w, r)
+				return
+			}
+		}
+		if revoked {
+			logger.Infof("jwt auth: token issued before session revocation, subject=%s", subject)
+			writeUnauthorized(w, r)
 			return
 		}
 
@@ -149,95 +146,27 @@ func (m *JwtAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 		ctx := context.WithValue(r.Context(), ctxKeySubject, subject)
 		ctx = context.WithValue(ctx, ctxKeyToken, tokenStr)
 		ctx = context.WithValue(ctx, ctxKeyTokenExpiry, expiration.Time)
+		ctx = context.WithValue(ctx, ctxKeyRoles, stringListClaim(claims["roles"]))
 		next(w, r.WithContext(ctx))
 	}
 }
 
 // writeUnauthorized returns a generic 401 response without leaking internal details.
-func writeUnauthorized(w http.ResponseWriter) {
-	httpx.WriteJson(w, http.StatusUnauthorized, map[string]string{"message": "unauthorized"})
+func writeUnauthorized(w http.ResponseWriter, r *http.Request) {
+	httpx.ErrorCtx(r.Context(), w, status.Error(codes.Unauthenticated, "unauthorized"))
 }
 
-// getKeys returns cached RSA public keys, refreshing from auth-service via gRPC when expired.
-func (m *JwtAuthMiddleware) getKeys(ctx context.Context) ([]rsaPublicKey, error) {
-	m.mu.RLock()
-	if m.keys != nil && !m.isExpired() {
-		defer m.mu.RUnlock()
-		return m.keys, nil
-	}
-	m.mu.RUnlock()
-
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	// Double-check after acquiring write lock.
-	if m.keys != nil && !m.isExpired() {
-		return m.keys, nil
-	}
-
-	resp, err := m.authService.GetJwks(ctx, &authpb.Empty{})
-	if err != nil {
-		return nil, err
-	}
-
-	keys, err := parseJwksResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	m.keys = keys
-	m.fetchedAt = time.Now()
-	return m.keys, nil
+// writeForbidden returns a generic 403 response for authenticated callers lacking a role.
+func writeForbidden(w http.ResponseWriter, r *http.Request) {
+	httpx.ErrorCtx(r.Context(), w, status.Error(codes.PermissionDenied, "forbidden"))
 }
 
-// parseJwksResponse converts the gRPC JwksResponse into RSA public keys.
-func parseJwksResponse(resp *authpb.JwksResponse) ([]rsaPublicKey, error) {
-	if resp == nil || len(resp.Keys) == 0 {
-		return nil, errors.New("empty jwks response")
-	}
-
-	var keys []rsaPublicKey
-	for _, k := range resp.Keys {
-		if k.Kty != "RSA" {
-			continue
-		}
-
-		nBytes, err := base64.RawURLEncoding.DecodeString(k.N)
-		if err != nil {
-			return nil, errors.New("invalid jwk: failed to decode n")
-		}
-		eBytes, err := base64.RawURLEncoding.DecodeString(k.E)
-		if err != nil {
-			return nil, errors.New("invalid jwk: failed to decode e")
-		}
-
-		pubKey := &rsa.PublicKey{
-			N: new(big.Int).SetBytes(nBytes),
-			E: int(new(big.Int).SetBytes(eBytes).Int64()),
-		}
-
-		keys = append(keys, rsaPublicKey{
-			Kid:       k.Kid,
-			PublicKey: pubKey,
-		})
-	}
-
-	if len(keys) == 0 {
-		return nil, errors.New("no valid RSA keys in jwks response")
-	}
-	return keys, nil
-}
-
-func (m *JwtAuthMiddleware) isExpired() bool {
-	ttl := time.Duration(m.cfg.CacheSeconds) * time.Second
-	if ttl <= 0 {
-		ttl = 5 * time.Minute
-	}
-	return time.Since(m.fetchedAt) > ttl
+func bearerToken(r *http.Request) (string, error) {
+	return ParseBearerToken(r.Header.Get("Authorization"))
 }
 
-func bearerToken(r *http.Request) (string, error) {
-	auth := r.Header.Get("Authorization")
+// ParseBearerToken extracts the token from an Authorization header value.
+func ParseBearerToken(auth string) (string, error) {
 	if auth == "" {
 		return "", errors.New("missing Authorization header")
 	}
@@ -272,14 +201,80 @@ func TokenExpiryFromContext(ctx context.Context) (time.Time, bool) {
 	return expiry, true
 }
 
+// RolesFromContext returns the roles claim of the authenticated token.
+func RolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(ctxKeyRoles).([]string)
+	return roles
+}
+
+// stringListClaim accepts either a JSON array of strings or a single string.
+func stringListClaim(v any) []string {
+	switch val := v.(type) {
+	case string:
+		if strings.TrimSpace(val) == "" {
+			return nil
+		}
+		return []string{strings.TrimSpace(val)}
+	case []any:
+		out := make([]string, 0, len(val))
+		for _, item := range val {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
 func TokenBlacklistKey(token string) string {
 	sum := sha256.Sum256([]byte(token))
 	return tokenBlacklistPrefix + hex.EncodeToString(sum[:])
 }
 
-func (m *JwtAuthMiddleware) isTokenBlacklisted(ctx context.Context, token string) (bool, error) {
-	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
-	defer cancel()
+// TokenRevokedKey returns the redis key holding the unix milliseconds up to which all tokens of userID are invalid.
+func TokenRevokedKey(userID string) string {
+	return tokenRevokedPrefix + userID
+}
 
-	return m.redis.ExistsCtx(ctx, TokenBlacklistKey(token))
+// IsRevokedAt reports whether a token issued at issuedAtMillis is invalidated by the watermark value
+// stored in redis. A token without an issue time is treated as revoked.
+func IsRevokedAt(watermark string, issuedAtMillis int64) bool {
+	revokedUpTo, err := strconv.ParseInt(watermark, 10, 64)
+	if err != nil {
+		return false
+	}
+	if issuedAtMillis <= 0 {
+		return true
+	}
+	return issuedAtMillis <= watermarkMillis(revokedUpTo)
+}
+
+// watermarkMillis converts a watermark to unix milliseconds. A legacy watermark in seconds covers
+// its whole second, as it did when it was written.
+func watermarkMillis(before int64) int64 {
+	if before < legacyWatermarkLimit {
+		return before*1000 + 999
+	}
+	return before
+}
+
+// IssuedAtMillis returns when a token was issued in unix milliseconds, or 0 if it does not say.
+// Tokens issued before auth-service added iat_ms fall back to iat at second precision, which
+// places them at the start of their second so a same-second revocation still covers them.
+func IssuedAtMillis(claims jwt.MapClaims) int64 {
+	switch v := claims[claimIssuedAtMillis].(type) {
+	case float64:
+		return int64(v)
+	case json.Number:
+		if ms, err := v.Int64(); err == nil {
+			return ms
+		}
+	}
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return 0
+	}
+	return iat.UnixMilli()
 }
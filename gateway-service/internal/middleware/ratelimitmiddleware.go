@@ -0,0 +1,252 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/config"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stringx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const rateLimitPrefix = "gateway:ratelimit:"
+
+var errUnexpectedScriptResult = errors.New("unexpected rate limit script result")
+
+// phoneLoginRegex matches a login the user-service treats as a phone number once spaces and
+// dashes are removed.
+var phoneLoginRegex = regexp.MustCompile(`^\+?[0-9]{6,15}$`)
+
+// slidingWindowScript keeps one sorted-set entry per accepted request, scored by its time in ms.
+// It returns {allowed, retryAfterMs}.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call("ZREMRANGEBYSCORE", key, 0, now - window)
+if redis.call("ZCARD", key) >= limit then
+	local oldest = redis.call("ZRANGE", key, 0, 0, "WITHSCORES")
+	local retry = window
+	if oldest[2] then
+		retry = tonumber(oldest[2]) + window - now
+	end
+	return {0, retry}
+end
+redis.call("ZADD", key, now, ARGV[4])
+redis.call("PEXPIRE", key, window)
+return {1, 0}
+`)
+
+// RateLimitMiddleware throttles unauthenticated credential endpoints with a redis sliding window,
+// once per client IP and once per username found in the JSON body.
+// Redis errors let the request through; the user-service lockout still guards the password check.
+type RateLimitMiddleware struct {
+	cfg     config.RateLimitConf
+	window  time.Duration
+	redis   *redis.Redis
+	proxies []netip.Prefix
+}
+
+func NewRateLimitMiddleware(cfg config.RateLimitConf, redisClient *redis.Redis) (*RateLimitMiddleware, error) {
+	proxies := make([]netip.Prefix, 0, len(cfg.TrustedProxies))
+	for _, entry := range cfg.TrustedProxies {
+		prefix, err := parseProxyPrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("rate limit: invalid trusted proxy %q: %w", entry, err)
+		}
+		proxies = append(proxies, prefix)
+	}
+	return &RateLimitMiddleware{
+		cfg:     cfg,
+		window:  time.Duration(cfg.WindowSeconds) * time.Second,
+		redis:   redisClient,
+		proxies: proxies,
+	}, nil
+}
+
+func (m *RateLimitMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !m.cfg.Enabled || m.redis == nil || m.window <= 0 {
+			next(w, r)
+			return
+		}
+		logger := logx.WithContext(r.Context())
+
+		// Step 1: Limit by client IP.
+		ip := m.clientIP(r)
+		if ip != "" && m.cfg.PerIP > 0 {
+			allowed, retryAfter, err := m.allow(r.Context(), rateLimitKey(r.URL.Path, "ip", ip), m.cfg.PerIP)
+			if err != nil {
+				logger.Errorf("rate limit: redis check failed: %v", err)
+			} else if !allowed {
+				logger.Infof("rate limit: ip %s exceeded %d requests per %s on %s", ip, m.cfg.PerIP, m.window, r.URL.Path)
+				writeTooManyRequests(w, r, retryAfter)
+				return
+			}
+		}
+
+		// Step 2: Limit by username, so one account cannot be sprayed from many IPs.
+		if m.cfg.PerUsername > 0 {
+			if username := usernameFromBody(r); username != "" {
+				allowed, retryAfter, err := m.allow(r.Context(), rateLimitKey(r.URL.Path, "user", username), m.cfg.PerUsername)
+				if err != nil {
+					logger.Errorf("rate limit: redis check failed: %v", err)
+				} else if !allowed {
+					logger.Infof("rate limit: username %s exceeded %d requests per %s on %s", username, m.cfg.PerUsername, m.window, r.URL.Path)
+					writeTooManyRequests(w, r, retryAfter)
+					return
+				}
+			}
+		}
+
+		next(w, r)
+	}
+}
+
+// allow records one request under key and reports whether it fits in the window.
+func (m *RateLimitMiddleware) allow(ctx context.Context, key string, limit int) (bool, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	member := strconv.FormatInt(now, 10) + "-" + stringx.Randn(8)
+	result, err := m.redis.ScriptRunCtx(ctx, slidingWindowScript, []string{key},
+		now, m.window.Milliseconds(), limit, member)
+	if err != nil {
+		return false, 0, err
+	}
+
+	values, ok := result.([]any)
+	if !ok || len(values) != 2 {
+		return false, 0, errUnexpectedScriptResult
+	}
+	allowed, _ := values[0].(int64)
+	retryMs, _ := values[1].(int64)
+	return allowed == 1, time.Duration(retryMs) * time.Millisecond, nil
+}
+
+// clientIP returns the address the request came from, without port. Forwarding headers are
+// only read when the peer is a trusted proxy: X-Real-IP first, else the right-most
+// X-Forwarded-For hop that is not itself a trusted proxy. Anything to its left was written by
+// the client and cannot be trusted.
+func (m *RateLimitMiddleware) clientIP(r *http.Request) string {
+	host := r.RemoteAddr
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	peer, err := netip.ParseAddr(host)
+	if err != nil {
+		return host
+	}
+	peer = peer.Unmap()
+	if !m.trusted(peer) {
+		return peer.String()
+	}
+
+	if realIP, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP"))); err == nil {
+		return realIP.Unmap().String()
+	}
+	hops := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		hop = hop.Unmap()
+		if !m.trusted(hop) {
+			return hop.String()
+		}
+	}
+	return peer.String()
+}
+
+func (m *RateLimitMiddleware) trusted(addr netip.Addr) bool {
+	for _, prefix := range m.proxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseProxyPrefix accepts a CIDR or a single address.
+func parseProxyPrefix(entry string) (netip.Prefix, error) {
+	entry = strings.TrimSpace(entry)
+	if strings.Contains(entry, "/") {
+		prefix, err := netip.ParsePrefix(entry)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func rateLimitKey(path, kind, value string) string {
+	return rateLimitPrefix + path + ":" + kind + ":" + value
+}
+
+// usernameFromBody peeks at the JSON username and restores the body for the handler. The login is
+// normalized the way the user-service looks it up, so reformatting a phone number or email does
+// not get a fresh budget.
+func usernameFromBody(r *http.Request) string {
+	if r.Body == nil {
+		return ""
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var payload struct {
+		Username string `json:"username"`
+	}
+	if json.Unmarshal(body, &payload) != nil {
+		return ""
+	}
+	return normalizeLoginKey(payload.Username)
+}
+
+// normalizeLoginKey mirrors the user-service login normalization: NFKC, trimmed, emails
+// lowercased and phone numbers without separators.
+func normalizeLoginKey(login string) string {
+	login = strings.ToLower(norm.NFKC.String(strings.TrimSpace(login)))
+	if strings.Contains(login, "@") {
+		return login
+	}
+	if phone := strings.NewReplacer(" ", "", "-", "").Replace(login); phoneLoginRegex.MatchString(phone) {
+		return phone
+	}
+	return login
+}
+
+// writeTooManyRequests returns 429 with a Retry-After hint rounded up to whole seconds.
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	seconds := int64((retryAfter + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	httpx.ErrorCtx(r.Context(), w, status.Error(codes.ResourceExhausted, "too many requests"))
+}
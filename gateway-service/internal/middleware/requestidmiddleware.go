@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/errorx"
+
+	"github.com/zeromicro/go-zero/core/trace"
+)
+
+const (
+	requestIDHeader    = "X-Request-Id"
+	requestIDMaxLength = 64
+)
+
+// RequestIDMiddleware tags every request with an ID and the caller's language.
+// The ID is taken from X-Request-Id when the client sends a sane one, otherwise from the trace,
+// and is echoed back in the response header and in error bodies.
+type RequestIDMiddleware struct{}
+
+func NewRequestIDMiddleware() *RequestIDMiddleware {
+	return &RequestIDMiddleware{}
+}
+
+func (m *RequestIDMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = trace.TraceIDFromContext(r.Context())
+		}
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+
+		ctx := errorx.WithRequestID(r.Context(), requestID)
+		ctx = errorx.WithLanguage(ctx, errorx.ParseLanguage(r.Header.Get("Accept-Language")))
+		next(w, r.WithContext(ctx))
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > requestIDMaxLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
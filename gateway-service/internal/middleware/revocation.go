@@ -0,0 +1,398 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/config"
+
+	red "github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+const (
+	revocationChannel      = "gateway:token:revocations"
+	revocationKindToken    = "token"
+	revocationKindSession  = "session"
+	revocationScanCount    = 500
+	revocationPingInterval = 30 * time.Second
+	revocationRetryDelay   = 3 * time.Second
+	revocationPruneEvery   = time.Minute
+	revocationResyncEvery  = 5 * time.Minute
+)
+
+// revocationEvent is published on revocationChannel whenever a token is blacklisted
+// or a session watermark is set, so every gateway instance can update its local copy.
+type revocationEvent struct {
+	Kind     string `json:"kind"`
+	Key      string `json:"key"`
+	Before   int64  `json:"before,omitempty"`
+	ExpireAt int64  `json:"expireAt"`
+}
+
+type watermark struct {
+	// before is in unix milliseconds.
+	before   int64
+	expireAt time.Time
+}
+
+// RevocationStore answers "is this token revoked" for the request path.
+// Redis stays the source of truth. With LocalRevocation enabled, blacklisted token hashes and
+// session watermarks are mirrored in memory from a redis snapshot plus pub/sub events, and
+// lookups are served locally while the subscription is healthy. Until the first sync completes,
+// after a lost subscription, or when the local set outgrows LocalRevocationMaxEntries, lookups
+// go to redis directly and RedisFailPolicy decides what a redis error means.
+type RevocationStore struct {
+	redis      *redis.Redis
+	pubsub     red.UniversalClient
+	failOpen   bool
+	maxEntries int
+
+	synced atomic.Bool
+	mu     sync.RWMutex
+	tokens map[string]time.Time
+	marks  map[string]watermark
+}
+
+func NewRevocationStore(cfg config.JwtAuthConf, redisConf redis.RedisConf, redisClient *redis.Redis) *RevocationStore {
+	s := &RevocationStore{
+		redis:      redisClient,
+		failOpen:   cfg.RedisFailPolicy == "open",
+		maxEntries: cfg.LocalRevocationMaxEntries,
+		tokens:     make(map[string]time.Time),
+		marks:      make(map[string]watermark),
+	}
+	if cfg.LocalRevocation {
+		s.pubsub = newPubSubClient(redisConf)
+		threading.GoSafe(s.syncLoop)
+		threading.GoSafe(s.pruneLoop)
+	}
+	return s
+}
+
+// FailOpen reports whether requests should proceed when a redis lookup fails.
+func (s *RevocationStore) FailOpen() bool {
+	return s.failOpen
+}
+
+// Blacklist marks a single token as revoked for ttl.
+func (s *RevocationStore) Blacklist(ctx context.Context, token string, ttl time.Duration) error {
+	key := TokenBlacklistKey(token)
+	seconds := ttlSeconds(ttl)
+
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	if err := s.redis.SetexCtx(ctx, key, tokenBlacklistValue, seconds); err != nil {
+		return err
+	}
+
+	expireAt := time.Now().Add(time.Duration(seconds) * time.Second)
+	s.addToken(key, expireAt)
+	s.publish(ctx, revocationEvent{Kind: revocationKindToken, Key: key, ExpireAt: expireAt.Unix()})
+	return nil
+}
+
+// RevokeSessions invalidates every token of userID issued up to now, for ttl.
+func (s *RevocationStore) RevokeSessions(ctx context.Context, userID string, ttl time.Duration) error {
+	key := TokenRevokedKey(userID)
+	seconds := ttlSeconds(ttl)
+	before := time.Now().UnixMilli()
+
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	if err := s.redis.SetexCtx(ctx, key, strconv.FormatInt(before, 10), seconds); err != nil {
+		return err
+	}
+
+	expireAt := time.Now().Add(time.Duration(seconds) * time.Second)
+	s.addMark(key, watermark{before: before, expireAt: expireAt})
+	s.publish(ctx, revocationEvent{Kind: revocationKindSession, Key: key, Before: before, ExpireAt: expireAt.Unix()})
+	return nil
+}
+
+// IsBlacklisted reports whether the token was individually revoked.
+func (s *RevocationStore) IsBlacklisted(ctx context.Context, token string) (bool, error) {
+	key := TokenBlacklistKey(token)
+	if s.useLocal() {
+		s.mu.RLock()
+		expireAt, ok := s.tokens[key]
+		s.mu.RUnlock()
+		return ok && time.Now().Before(expireAt), nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	return s.redis.ExistsCtx(ctx, key)
+}
+
+// IsRevoked reports whether a token of userID issued at issuedAtMillis predates a "log out everywhere".
+func (s *RevocationStore) IsRevoked(ctx context.Context, userID string, issuedAtMillis int64) (bool, error) {
+	key := TokenRevokedKey(userID)
+	if s.useLocal() {
+		s.mu.RLock()
+		mark, ok := s.marks[key]
+		s.mu.RUnlock()
+		if !ok || time.Now().After(mark.expireAt) {
+			return false, nil
+		}
+		return IsRevokedAt(strconv.FormatInt(mark.before, 10), issuedAtMillis), nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	value, err := s.redis.GetCtx(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if value == "" {
+		return false, nil
+	}
+	return IsRevokedAt(value, issuedAtMillis), nil
+}
+
+func (s *RevocationStore) useLocal() bool {
+	if !s.synced.Load() {
+		return false
+	}
+	if s.maxEntries <= 0 {
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.tokens)+len(s.marks) <= s.maxEntries
+}
+
+func (s *RevocationStore) addToken(key string, expireAt time.Time) {
+	if s.pubsub == nil {
+		return
+	}
+	s.mu.Lock()
+	s.tokens[key] = expireAt
+	s.mu.Unlock()
+}
+
+func (s *RevocationStore) addMark(key string, mark watermark) {
+	if s.pubsub == nil {
+		return
+	}
+	s.mu.Lock()
+	if current, ok := s.marks[key]; !ok || mark.before >= current.before {
+		s.marks[key] = mark
+	}
+	s.mu.Unlock()
+}
+
+func (s *RevocationStore) publish(ctx context.Context, event revocationEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("revocation: marshal event failed: %v", err)
+		return
+	}
+	// The key is already in redis; instances that miss the event pick it up on their next
+	// periodic snapshot reload, so a failed publish delays rather than loses the revocation.
+	if _, err := s.redis.PublishCtx(ctx, revocationChannel, string(payload)); err != nil {
+		logx.WithContext(ctx).Errorf("revocation: publish event failed: %v", err)
+	}
+}
+
+func (s *RevocationStore) apply(payload string) {
+	var event revocationEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		logx.Errorf("revocation: invalid event %q: %v", payload, err)
+		return
+	}
+	expireAt := time.Unix(event.ExpireAt, 0)
+	switch event.Kind {
+	case revocationKindToken:
+		s.addToken(event.Key, expireAt)
+	case revocationKindSession:
+		s.addMark(event.Key, watermark{before: watermarkMillis(event.Before), expireAt: expireAt})
+	}
+}
+
+// syncLoop subscribes first and then snapshots redis, so no event published in between is lost.
+// Any subscription error drops back to redis lookups until a fresh snapshot is loaded.
+func (s *RevocationStore) syncLoop() {
+	ctx := context.Background()
+	for {
+		if err := s.syncOnce(ctx); err != nil {
+			logx.Errorf("revocation: local sync interrupted, falling back to redis lookups: %v", err)
+		}
+		s.synced.Store(false)
+		time.Sleep(revocationRetryDelay)
+	}
+}
+
+func (s *RevocationStore) syncOnce(ctx context.Context) error {
+	ps := s.pubsub.Subscribe(ctx, revocationChannel)
+	defer ps.Close()
+
+	if _, err := ps.Receive(ctx); err != nil {
+		return err
+	}
+	if err := s.loadSnapshot(ctx); err != nil {
+		return err
+	}
+	s.synced.Store(true)
+	tokens, sessions := s.size()
+	logx.Infof("revocation: local cache synced, tokens=%d sessions=%d", tokens, sessions)
+
+	lastSnapshot := time.Now()
+	for {
+		if time.Since(lastSnapshot) >= revocationResyncEvery {
+			if err := s.loadSnapshot(ctx); err != nil {
+				return err
+			}
+			lastSnapshot = time.Now()
+		}
+
+		msg, err := ps.ReceiveTimeout(ctx, revocationPingInterval)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				if err := ps.Ping(ctx); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+		if m, ok := msg.(*red.Message); ok {
+			s.apply(m.Payload)
+		}
+	}
+}
+
+func (s *RevocationStore) loadSnapshot(ctx context.Context) error {
+	tokens := make(map[string]time.Time)
+	marks := make(map[string]watermark)
+
+	if err := s.scan(ctx, tokenBlacklistPrefix+"*", func(key, _ string, ttl time.Duration) {
+		tokens[key] = time.Now().Add(ttl)
+	}); err != nil {
+		return err
+	}
+	if err := s.scan(ctx, tokenRevokedPrefix+"*", func(key, value string, ttl time.Duration) {
+		before, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return
+		}
+		marks[key] = watermark{before: watermarkMillis(before), expireAt: time.Now().Add(ttl)}
+	}); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	// Keep entries applied from events that arrived while the snapshot was loading.
+	for key, expireAt := range s.tokens {
+		tokens[key] = expireAt
+	}
+	for key, mark := range s.marks {
+		if current, ok := marks[key]; !ok || mark.before >= current.before {
+			marks[key] = mark
+		}
+	}
+	s.tokens = tokens
+	s.marks = marks
+	s.mu.Unlock()
+	return nil
+}
+
+// scan walks keys matching pattern and reports each with its value and remaining ttl.
+// Keys without a ttl are skipped since every revocation entry is written with SETEX.
+func (s *RevocationStore) scan(ctx context.Context, pattern string, fn func(key, value string, ttl time.Duration)) error {
+	var cursor uint64
+	for {
+		keys, next, err := s.redis.ScanCtx(ctx, cursor, pattern, revocationScanCount)
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			var (
+				getCmds = make([]*red.StringCmd, len(keys))
+				ttlCmds = make([]*red.DurationCmd, len(keys))
+			)
+			if err := s.redis.PipelinedCtx(ctx, func(p redis.Pipeliner) error {
+				for i, key := range keys {
+					getCmds[i] = p.Get(ctx, key)
+					ttlCmds[i] = p.TTL(ctx, key)
+				}
+				return nil
+			}); err != nil && !errors.Is(err, red.Nil) {
+				return err
+			}
+			for i, key := range keys {
+				value, err := getCmds[i].Result()
+				if err != nil {
+					continue
+				}
+				if ttl := ttlCmds[i].Val(); ttl > 0 {
+					fn(key, value, ttl)
+				}
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+func (s *RevocationStore) pruneLoop() {
+	ticker := time.NewTicker(revocationPruneEvery)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		now := time.Now()
+		s.mu.Lock()
+		for key, expireAt := range s.tokens {
+			if now.After(expireAt) {
+				delete(s.tokens, key)
+			}
+		}
+		for key, mark := range s.marks {
+			if now.After(mark.expireAt) {
+				delete(s.marks, key)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *RevocationStore) size() (int, int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.tokens), len(s.marks)
+}
+
+func ttlSeconds(ttl time.Duration) int {
+	seconds := int(ttl.Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	return seconds
+}
+
+// newPubSubClient builds a go-redis client for subscriptions, which go-zero's redis wrapper does not expose.
+func newPubSubClient(c redis.RedisConf) red.UniversalClient {
+	opts := &red.UniversalOptions{
+		Addrs:         strings.Split(c.Host, ","),
+		Username:      c.User,
+		Password:      c.Pass,
+		IsClusterMode: c.Type == redis.ClusterType,
+	}
+	if c.Tls {
+		opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return red.NewUniversalClient(opts)
+}
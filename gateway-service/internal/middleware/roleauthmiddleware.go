@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// RoleAuthMiddleware enforces the roles carried in the access token.
+// It must be placed after JwtAuth in a route group's middleware list.
+// A request passes when it holds any of the roles.
+type RoleAuthMiddleware struct {
+	roles []string
+}
+
+func NewRoleAuthMiddleware(roles []string) *RoleAuthMiddleware {
+	return &RoleAuthMiddleware{
+		roles: roles,
+	}
+}
+
+func (m *RoleAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := logx.WithContext(r.Context())
+
+		subject, ok := SubjectFromContext(r.Context())
+		if !ok {
+			logger.Errorf("role auth: no authenticated subject, is JwtAuth missing before it?")
+			writeUnauthorized(w, r)
+			return
+		}
+
+		if len(m.roles) > 0 {
+			granted := RolesFromContext(r.Context())
+			if !slices.ContainsFunc(m.roles, func(role string) bool { return slices.Contains(granted, role) }) {
+				logger.Infof("role auth: missing role, subject=%s required=%v granted=%v", subject, m.roles, granted)
+				writeForbidden(w, r)
+				return
+			}
+		}
+
+		next(w, r)
+	}
+}
@@ -0,0 +1,49 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"regexp"
+)
+
+const fakeDefaultCode = "fake-user"
+
+var fakeCodeRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// FakeProvider skips the consent page: AuthCodeURL points straight back at the callback, and
+// Exchange accepts any well-formed code, using it as the openid. Never enable it in production.
+type FakeProvider struct {
+	name        string
+	redirectURI string
+}
+
+func NewFakeProvider(name, redirectURI string) *FakeProvider {
+	return &FakeProvider{name: name, redirectURI: redirectURI}
+}
+
+func (p *FakeProvider) Name() string {
+	return p.name
+}
+
+func (p *FakeProvider) AuthCodeURL(state string) string {
+	query := url.Values{"code": {fakeDefaultCode}, "state": {state}}
+	return p.redirectURI + "?" + query.Encode()
+}
+
+func (p *FakeProvider) Exchange(_ context.Context, code string) (*Identity, error) {
+	if !fakeCodeRegex.MatchString(code) {
+		return nil, errors.New("invalid authorization code")
+	}
+	raw, err := json.Marshal(map[string]string{"openid": code, "nickname": code})
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{
+		OpenID:      code,
+		Nickname:    code,
+		AccessToken: "fake-access-" + code,
+		RawData:     string(raw),
+	}, nil
+}
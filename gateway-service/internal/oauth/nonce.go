@@ -0,0 +1,89 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+)
+
+// NonceCookie carries the browser half of a state. The callback only accepts a state from the
+// browser that started the flow, so a victim cannot be made to finish an attacker's login or bind.
+const (
+	NonceCookie     = "oauth_nonce"
+	nonceCookiePath = "/api/v1/oauth/"
+)
+
+type ctxKey int
+
+const ctxKeyNonce ctxKey = iota
+
+// NewNonce returns a random browser nonce.
+func NewNonce() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// WithNonce passes the browser nonce of the request from its handler to the logic.
+func WithNonce(ctx context.Context, nonce string) context.Context {
+	return context.WithValue(ctx, ctxKeyNonce, nonce)
+}
+
+func NonceFromContext(ctx context.Context) (string, bool) {
+	nonce, ok := ctx.Value(ctxKeyNonce).(string)
+	return nonce, ok && nonce != ""
+}
+
+// hashNonce is what a state stores, so reading redis does not reveal the cookie value.
+func hashNonce(nonce string) string {
+	sum := sha256.Sum256([]byte(nonce))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchesNonce reports whether nonce is the browser nonce the state was created with.
+func (st *State) MatchesNonce(nonce string) bool {
+	if nonce == "" || st.NonceHash == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hashNonce(nonce)), []byte(st.NonceHash)) == 1
+}
+
+// SetNonceCookie hands nonce to the browser, scoped to the callback routes.
+func SetNonceCookie(w http.ResponseWriter, nonce string, seconds int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     NonceCookie,
+		Value:    nonce,
+		Path:     nonceCookiePath,
+		MaxAge:   seconds,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// ClearNonceCookie removes the nonce once a callback has used it.
+func ClearNonceCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     NonceCookie,
+		Value:    "",
+		Path:     nonceCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// NonceFromRequest returns the nonce cookie sent with a callback, or "".
+func NonceFromRequest(r *http.Request) string {
+	cookie, err := r.Cookie(NonceCookie)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
@@ -0,0 +1,67 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/config"
+)
+
+const ProviderTypeFake = "fake"
+
+var platformRegex = regexp.MustCompile(`^[a-z0-9_]{1,20}$`)
+
+// Identity is the account a provider vouched for after a successful exchange.
+type Identity struct {
+	OpenID       string
+	UnionID      string
+	Nickname     string
+	Avatar       string
+	AccessToken  string
+	RefreshToken string
+	// ExpiresAt is a unix timestamp in seconds, 0 when the provider did not say.
+	ExpiresAt int64
+	// RawData is the provider's user payload as JSON.
+	RawData string
+}
+
+// Provider implements the authorization code flow for one third-party platform.
+type Provider interface {
+	// Name is the platform stored with bindings.
+	Name() string
+	// AuthCodeURL returns the page the user is sent to for consent.
+	AuthCodeURL(state string) string
+	// Exchange trades an authorization code for the user's identity.
+	Exchange(ctx context.Context, code string) (*Identity, error)
+}
+
+// Registry holds the configured providers by platform name.
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry(c config.OAuthConf) (*Registry, error) {
+	r := &Registry{providers: make(map[string]Provider, len(c.Providers))}
+	for _, pc := range c.Providers {
+		if !platformRegex.MatchString(pc.Name) {
+			return nil, fmt.Errorf("invalid oauth platform name %q", pc.Name)
+		}
+		if _, ok := r.providers[pc.Name]; ok {
+			return nil, fmt.Errorf("duplicate oauth platform %q", pc.Name)
+		}
+		switch pc.Type {
+		case "", ProviderTypeFake:
+			r.providers[pc.Name] = NewFakeProvider(pc.Name, pc.RedirectURI)
+		default:
+			return nil, fmt.Errorf("unsupported oauth provider type %q", pc.Type)
+		}
+	}
+	return r, nil
+}
+
+// Get returns the provider for platform, or false when it is not configured.
+func (r *Registry) Get(platform string) (Provider, bool) {
+	p, ok := r.providers[platform]
+	return p, ok
+}
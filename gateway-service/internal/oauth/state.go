@@ -0,0 +1,111 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const statePrefix = "gateway:oauth:state:"
+
+// Flow modes recorded with a state.
+const (
+	ModeLogin = "login"
+	ModeBind  = "bind"
+)
+
+// ErrStateNotFound means the state is unknown, expired or already used.
+var ErrStateNotFound = errors.New("oauth state not found")
+
+// ErrStatePlatform and ErrStateBrowser mean the state was issued for another platform or to
+// another browser. Such a state is kept, so a forged callback cannot burn the legitimate one.
+var (
+	ErrStatePlatform = errors.New("oauth state issued for another platform")
+	ErrStateBrowser  = errors.New("oauth state issued to another browser")
+)
+
+// consumeStateScript deletes KEYS[1] only while it still holds ARGV[1], so of two callbacks
+// racing on one state exactly one consumes it.
+var consumeStateScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// State is what the gateway remembers between the authorize redirect and the callback.
+type State struct {
+	Platform string `json:"platform"`
+	Mode     string `json:"mode"`
+	// UserID is the signed-in user for ModeBind; the callback itself carries no token.
+	UserID string `json:"userId,omitempty"`
+	// NonceHash binds the state to the browser holding the matching NonceCookie.
+	NonceHash string `json:"nonceHash"`
+}
+
+// StateStore keeps single-use states in redis.
+type StateStore struct {
+	redis   *redis.Redis
+	seconds int
+}
+
+func NewStateStore(redisClient *redis.Redis, seconds int) *StateStore {
+	return &StateStore{redis: redisClient, seconds: seconds}
+}
+
+// Create stores st, bound to the browser nonce, under a new random state and returns it.
+func (s *StateStore) Create(ctx context.Context, st State, nonce string) (string, error) {
+	st.NonceHash = hashNonce(nonce)
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	state := hex.EncodeToString(buf)
+	payload, err := json.Marshal(st)
+	if err != nil {
+		return "", err
+	}
+	if err := s.redis.SetexCtx(ctx, statePrefix+state, string(payload), s.seconds); err != nil {
+		return "", err
+	}
+	return state, nil
+}
+
+// Consume checks the state was issued for platform to the browser holding nonce, and only then
+// deletes it, so a callback cannot be replayed.
+func (s *StateStore) Consume(ctx context.Context, state, platform, nonce string) (*State, error) {
+	if state == "" {
+		return nil, ErrStateNotFound
+	}
+	key := statePrefix + state
+	payload, err := s.redis.GetCtx(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if payload == "" {
+		return nil, ErrStateNotFound
+	}
+	var st State
+	if err := json.Unmarshal([]byte(payload), &st); err != nil {
+		return nil, err
+	}
+	if st.Platform != platform {
+		return nil, ErrStatePlatform
+	}
+	if !st.MatchesNonce(nonce) {
+		return nil, ErrStateBrowser
+	}
+
+	deleted, err := s.redis.ScriptRunCtx(ctx, consumeStateScript, []string{key}, payload)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := deleted.(int64); n == 0 {
+		return nil, ErrStateNotFound
+	}
+	return &st, nil
+}
@@ -4,36 +4,80 @@
 package svc
 
 import (
+	"context"
+
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/config"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/middleware"
+	"github.com/GUET-BAT/Astraios-S/gateway-service/internal/oauth"
 	"github.com/GUET-BAT/Astraios-S/gateway-service/pb/authpb"
 	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
 
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// user-service returns gRPC status errors instead of in-body codes to callers sending this metadata.
+const (
+	errorModeMetadataKey = "x-error-mode"
+	errorModeStatus      = "status"
 )
 
+// auth-service only serves IssueToken and RevokeTokens to callers presenting the shared credential.
+const internalTokenMetadataKey = "x-internal-token"
+
 type ServiceContext struct {
 	Config      config.Config
 	JwtAuth     rest.Middleware
+	AdminAuth   rest.Middleware
+	RateLimit   rest.Middleware
 	UserService userpb.UserServiceClient
 	AuthService authpb.AuthServiceClient
 	Redis       *redis.Redis
+	Revocations *middleware.RevocationStore
+	OAuth       *oauth.Registry
+	OAuthStates *oauth.StateStore
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
-	userClient := zrpc.MustNewClient(c.UserService)
-	authClient := zrpc.MustNewClient(c.AuthService)
+	userClient := zrpc.MustNewClient(c.UserService, zrpc.WithUnaryClientInterceptor(statusErrorsInterceptor))
+	authClient := zrpc.MustNewClient(c.AuthService, zrpc.WithUnaryClientInterceptor(internalTokenInterceptor(c.InternalToken)))
 
 	authSvcClient := authpb.NewAuthServiceClient(authClient.Conn())
 	redisClient := redis.MustNewRedis(c.CacheRedis)
+	revocations := middleware.NewRevocationStore(c.JwtAuth, c.CacheRedis, redisClient)
+	oauthProviders, err := oauth.NewRegistry(c.OAuth)
+	logx.Must(err)
+	rateLimit, err := middleware.NewRateLimitMiddleware(c.RateLimit, redisClient)
+	logx.Must(err)
 
 	return &ServiceContext{
 		Config:      c,
-		JwtAuth:     middleware.NewJwtAuthMiddleware(c.JwtAuth, authSvcClient, redisClient).Handle,
+		JwtAuth:     middleware.NewJwtAuthMiddleware(c.JwtAuth, authSvcClient, revocations).Handle,
+		AdminAuth:   middleware.NewAdminAuthMiddleware().Handle,
+		RateLimit:   rateLimit.Handle,
 		UserService: userpb.NewUserServiceClient(userClient.Conn()),
 		AuthService: authSvcClient,
 		Redis:       redisClient,
+		Revocations: revocations,
+		OAuth:       oauthProviders,
+		OAuthStates: oauth.NewStateStore(redisClient, c.OAuth.StateSeconds),
+	}
+}
+
+func statusErrorsInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, errorModeMetadataKey, errorModeStatus)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func internalTokenInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, internalTokenMetadataKey, token)
+		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
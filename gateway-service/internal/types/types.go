@@ -3,11 +3,169 @@
 
 package types
 
+type AdminSearchUsersRequest struct {
+	Username      string `form:"username,optional"`       // prefix match
+	Nickname      string `form:"nickname,optional"`       // prefix match
+	Status        string `form:"status,optional"`         // comma-separated: 0-disabled, 1-active, 2-pending, 3-deactivated
+	CreatedAfter  string `form:"created_after,optional"`  // RFC 3339
+	CreatedBefore string `form:"created_before,optional"` // RFC 3339
+	Cursor        string `form:"cursor,optional"`
+	Limit         int32  `form:"limit,optional"`
+}
+
+type AdminSearchUsersResponse struct {
+	Code int32                        `json:"code"`
+	Msg  string                       `json:"message,optional"`
+	Data AdminSearchUsersResponseData `json:"data"`
+}
+
+type AdminSearchUsersResponseData struct {
+	Users      []AdminUser `json:"users"`
+	NextCursor string      `json:"next_cursor,optional"`
+}
+
+type AdminSetUserStatusRequest struct {
+	UserId string `path:"user_id"`
+	Status int32  `json:"status"` // 0-disabled, 1-active
+}
+
+type AdminUser struct {
+	UserId    string `json:"user_id"`
+	Username  string `json:"username"`
+	Nickname  string `json:"nickname,optional"`
+	Status    int32  `json:"status"`
+	CreatedAt string `json:"created_at,optional"`
+	DeletedAt string `json:"deleted_at,optional"`
+	Purged    bool   `json:"purged"`
+}
+
+type AdminUserBindingsResponse struct {
+	Code int32                         `json:"code"`
+	Msg  string                        `json:"message,optional"`
+	Data AdminUserBindingsResponseData `json:"data"`
+}
+
+type AdminUserBindingsResponseData struct {
+	Identifiers   []IdentifierResponseData `json:"identifiers"`
+	OAuthBindings []OAuthBinding           `json:"oauth_bindings"`
+}
+
+type AdminUserRequest struct {
+	UserId string `path:"user_id"`
+}
+
+type AdminUserResponse struct {
+	Code int32     `json:"code"`
+	Msg  string    `json:"message,optional"`
+	Data AdminUser `json:"data"`
+}
+
+type AvatarUploadRequest struct {
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`          // exact size of the file in bytes
+	Slot        string `json:"slot,optional"` // avatar (default) or background; deprecated, use /media/presign
+}
+
 type AvatarUrlRequest struct {
+	Size int32 `form:"size,optional"` // 64, 128 or 512 px, rounded up; largest when omitted
 }
 
 type AvatarUrlResponse struct {
-	AvatarUrl string `json:"avatar_url"`
+	AvatarUrl  string            `json:"avatar_url"`
+	ObjectKey  string            `json:"object_key,optional"`  // pass to /avatar/confirm after uploading
+	FormFields map[string]string `json:"form_fields,optional"` // POST these to avatar_url, then the file as "file"
+	Processing bool              `json:"processing,optional"`  // confirmed, the new avatar appears once processed
+}
+
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password"`
+	NewPassword string `json:"new_password"`
+}
+
+type ConfirmAvatarRequest struct {
+	ObjectKey string `json:"object_key"`
+	Slot      string `json:"slot,optional"` // must match the slot the upload was started for
+}
+
+type DeactivateAccountRequest struct {
+}
+
+type DeactivateAccountResponse struct {
+	Code int32                         `json:"code"`
+	Msg  string                        `json:"message,optional"`
+	Data DeactivateAccountResponseData `json:"data"`
+}
+
+type DeactivateAccountResponseData struct {
+	PurgeAfter string `json:"purge_after"`
+}
+
+type EmailNotificationSettings struct {
+	Enabled        *bool `json:"enabled,optional"`
+	WeeklyDigest   *bool `json:"weekly_digest,optional"`
+	SecurityAlerts *bool `json:"security_alerts,optional"`
+}
+
+type FollowListRequest struct {
+	UserId string `path:"user_id"`
+	Cursor string `form:"cursor,optional"`
+	Limit  int32  `form:"limit,optional"`
+}
+
+type FollowListResponse struct {
+	Code int32                  `json:"code"`
+	Msg  string                 `json:"message,optional"`
+	Data FollowListResponseData `json:"data"`
+}
+
+type FollowListResponseData struct {
+	Users      []FollowUser `json:"users"`
+	NextCursor string       `json:"next_cursor,optional"`
+}
+
+type FollowRequest struct {
+	UserId string `json:"user_id"`
+}
+
+type FollowResponse struct {
+	Code int32        `json:"code"`
+	Msg  string       `json:"message,optional"`
+	Data FollowStatus `json:"data"`
+}
+
+type FollowStatus struct {
+	Following  bool `json:"following"`
+	FollowedBy bool `json:"followed_by"`
+}
+
+type FollowStatusRequest struct {
+	UserId string `form:"user_id"`
+}
+
+type FollowUser struct {
+	UserId     string `json:"user_id"`
+	Nickname   string `json:"nickname,optional"`
+	Avatar     string `json:"avatar,optional"`
+	FollowedAt string `json:"followed_at,optional"`
+}
+
+type IdentifierRequest struct {
+	AuthType   int32  `json:"auth_type"` // 1-phone, 2-email
+	Identifier string `json:"identifier,optional"`
+	Code       string `json:"code,optional"`
+}
+
+type IdentifierResponse struct {
+	Code int32                  `json:"code"`
+	Msg  string                 `json:"message,optional"`
+	Data IdentifierResponseData `json:"data"`
+}
+
+type IdentifierResponseData struct {
+	AuthType      int32  `json:"auth_type"`
+	Identifier    string `json:"identifier,optional"`
+	Verified      bool   `json:"verified"`
+	CodeExpiresIn int64  `json:"code_expires_in,optional"`
 }
 
 type LoginRequest struct {
@@ -36,6 +194,161 @@ type LogoutResponse struct {
 	Data string `json:"data,optional"`
 }
 
+type MediaConfirmRequest struct {
+	Slot      string `json:"slot"` // must match the slot the upload was started for
+	ObjectKey string `json:"object_key"`
+}
+
+type MediaUploadRequest struct {
+	Slot        string `json:"slot"` // avatar or background
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"` // exact size of the file in bytes
+}
+
+type MediaUploadResponse struct {
+	UploadUrl  string            `json:"upload_url,optional"` // POST form_fields here, then the file as "file"
+	ObjectKey  string            `json:"object_key"`          // pass to /media/confirm after uploading
+	FormFields map[string]string `json:"form_fields,optional"`
+	Processing bool              `json:"processing,optional"` // confirmed, the new image appears once processed
+}
+
+type NotificationSettings struct {
+	Push       *PushNotificationSettings  `json:"push,optional"`
+	Email      *EmailNotificationSettings `json:"email,optional"`
+	Sms        *SmsNotificationSettings   `json:"sms,optional"`
+	QuietHours *QuietHoursSettings        `json:"quiet_hours,optional"`
+}
+
+type OAuthAuthorizeRequest struct {
+	Platform string `path:"platform"`
+}
+
+type OAuthAuthorizeResponse struct {
+	Code int32                      `json:"code"`
+	Msg  string                     `json:"message,optional"`
+	Data OAuthAuthorizeResponseData `json:"data"`
+}
+
+type OAuthAuthorizeResponseData struct {
+	AuthorizeUrl string `json:"authorize_url"`
+	State        string `json:"state"`
+}
+
+type OAuthBinding struct {
+	Platform  string `json:"platform"`
+	Nickname  string `json:"nickname,optional"`
+	Avatar    string `json:"avatar,optional"`
+	CreatedAt string `json:"created_at,optional"`
+}
+
+type OAuthBindingsRequest struct {
+}
+
+type OAuthBindingsResponse struct {
+	Code int32                     `json:"code"`
+	Msg  string                    `json:"message,optional"`
+	Data OAuthBindingsResponseData `json:"data"`
+}
+
+type OAuthBindingsResponseData struct {
+	Bindings []OAuthBinding `json:"bindings"`
+}
+
+type OAuthCallbackRequest struct {
+	Platform string `path:"platform"`
+	Code     string `form:"code"`
+	State    string `form:"state"`
+}
+
+type OAuthCallbackResponse struct {
+	Code int32                     `json:"code"`
+	Msg  string                    `json:"message,optional"`
+	Data OAuthCallbackResponseData `json:"data"`
+}
+
+type OAuthCallbackResponseData struct {
+	Mode         string         `json:"mode"` // login or bind
+	AccessToken  string         `json:"access_token,optional"`
+	RefreshToken string         `json:"refresh_token,optional"`
+	Created      bool           `json:"created,optional"`
+	Bindings     []OAuthBinding `json:"bindings,optional"`
+}
+
+type OAuthUnbindRequest struct {
+	Platform string `json:"platform"`
+}
+
+type PasswordResetRequest struct {
+	Login string `json:"login"` // username, verified email or verified phone
+}
+
+type PasswordResponse struct {
+	Code int32  `json:"code"`
+	Msg  string `json:"message,optional"`
+	Data string `json:"data,optional"`
+}
+
+type PublicProfile struct {
+	UserId             string     `json:"user_id"`
+	Nickname           string     `json:"nickname,optional"`
+	AvatarUrl          string     `json:"avatar_url,optional"`
+	Gender             int32      `json:"gender,optional"`
+	Bio                string     `json:"bio,optional"`
+	BackgroundImageUrl string     `json:"background_image_url,optional"`
+	Country            string     `json:"country,optional"`
+	Province           string     `json:"province,optional"`
+	City               string     `json:"city,optional"`
+	School             string     `json:"school,optional"`
+	Major              string     `json:"major,optional"`
+	CreatedAt          string     `json:"created_at,optional"`
+	Stats              *UserStats `json:"stats,optional"`
+	Following          bool       `json:"following"`
+	FollowedBy         bool       `json:"followed_by"`
+	Restricted         bool       `json:"restricted"` // true when privacy settings hide the details
+}
+
+type PublicProfileRequest struct {
+	UserId string `path:"user_id"`
+}
+
+type PublicProfileResponse struct {
+	Code int32         `json:"code"`
+	Msg  string        `json:"message,optional"`
+	Data PublicProfile `json:"data"`
+}
+
+type PushNotificationSettings struct {
+	Enabled  *bool `json:"enabled,optional"`
+	Likes    *bool `json:"likes,optional"`
+	Comments *bool `json:"comments,optional"`
+	Follows  *bool `json:"follows,optional"`
+	Mentions *bool `json:"mentions,optional"`
+	Messages *bool `json:"messages,optional"`
+	System   *bool `json:"system,optional"`
+}
+
+type QuietHoursSettings struct {
+	Enabled *bool   `json:"enabled,optional"`
+	Start   *string `json:"start,optional"` // HH:MM
+	End     *string `json:"end,optional"`   // HH:MM
+}
+
+type ReactivateAccountRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type RefreshRequest struct {
+	RefreshToken  string `json:"refresh_token"`
+	Authorization string `header:"Authorization,optional"`
+}
+
+type RefreshResponse struct {
+	Code int32             `json:"code"`
+	Msg  string            `json:"message,optional"`
+	Data LoginResponseData `json:"data"`
+}
+
 type RegisterRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -48,6 +361,16 @@ type RegisterResponse struct {
 	Data string `json:"data,optional"`
 }
 
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+type SmsNotificationSettings struct {
+	Enabled        *bool `json:"enabled,optional"`
+	SecurityAlerts *bool `json:"security_alerts,optional"`
+}
+
 type UserDataRequest struct {
 	UserInfo UserInfo `json:"user_info,optional"`
 }
@@ -63,19 +386,51 @@ type UserDataResponseData struct {
 }
 
 type UserInfo struct {
-	Userid          string `json:"user_id,optional"`
-	Nickname        string `json:"nickname,optional"`
-	Avatar          string `json:"avatar,optional"`
-	Gender          int32  `json:"gender,optional"`
-	Birthday        string `json:"birthday,optional"`
-	Bio             string `json:"bio,optional"`
-	BackgroundImage string `json:"backgroundImage,optional"`
-	Country         string `json:"country,optional"`
-	Province        string `json:"province,optional"`
-	City            string `json:"city,optional"`
-	School          string `json:"school,optional"`
-	Major           string `json:"major,optional"`
-	GraduationYear  int32  `json:"graduation_year,optional"`
-	CreatedAt       string `json:"created_at,optional"`
-	UpdatedAt       string `json:"updated_at,optional"`
+	Userid             string `json:"user_id,optional"`
+	Nickname           string `json:"nickname,optional"`
+	Avatar             string `json:"avatar,optional"`
+	Gender             int32  `json:"gender,optional"`
+	Birthday           string `json:"birthday,optional"`
+	Bio                string `json:"bio,optional"`
+	BackgroundImage    string `json:"backgroundImage,optional"`
+	Country            string `json:"country,optional"`
+	Province           string `json:"province,optional"`
+	City               string `json:"city,optional"`
+	School             string `json:"school,optional"`
+	Major              string `json:"major,optional"`
+	GraduationYear     int32  `json:"graduation_year,optional"`
+	CreatedAt          string `json:"created_at,optional"`
+	UpdatedAt          string `json:"updated_at,optional"`
+	AvatarUrl          string `json:"avatar_url,optional"`
+	BackgroundImageUrl string `json:"background_image_url,optional"`
+}
+
+type UserSettings struct {
+	PrivacyLevel         *int32                `json:"privacy_level,optional"` // 0-private, 1-public, 2-followers only
+	AllowStrangerMsg     *bool                 `json:"allow_stranger_msg,optional"`
+	ShowOnlineStatus     *bool                 `json:"show_online_status,optional"`
+	ShowLikes            *bool                 `json:"show_likes,optional"`
+	ShowFollowing        *bool                 `json:"show_following,optional"`
+	ShowFollowers        *bool                 `json:"show_followers,optional"`
+	NotificationSettings *NotificationSettings `json:"notification_settings,optional"`
+	Language             *string               `json:"language,optional"`
+	Timezone             *string               `json:"timezone,optional"`
+	Theme                *string               `json:"theme,optional"` // auto, light or dark
+	UpdatedAt            string                `json:"updated_at,optional"`
+}
+
+type UserSettingsRequest struct {
+}
+
+type UserSettingsResponse struct {
+	Code int32        `json:"code"`
+	Msg  string       `json:"message,optional"`
+	Data UserSettings `json:"data"`
+}
+
+type UserStats struct {
+	FollowingCount int64 `json:"following_count"`
+	FollowerCount  int64 `json:"follower_count"`
+	LikesCount     int64 `json:"likes_count"`
+	VideoCount     int64 `json:"video_count"`
 }
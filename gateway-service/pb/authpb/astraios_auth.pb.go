@@ -172,6 +172,96 @@ func (x *LoginResponse) GetRefreshToken() string {
 	return ""
 }
 
+// 签发令牌请求
+type IssueTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueTokenRequest) Reset() {
+	*x = IssueTokenRequest{}
+	mi := &file_astraios_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTokenRequest) ProtoMessage() {}
+
+func (x *IssueTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_astraios_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTokenRequest.ProtoReflect.Descriptor instead.
+func (*IssueTokenRequest) Descriptor() ([]byte, []int) {
+	return file_astraios_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IssueTokenRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 吊销令牌请求
+type RevokeTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokensRequest) Reset() {
+	*x = RevokeTokensRequest{}
+	mi := &file_astraios_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokensRequest) ProtoMessage() {}
+
+func (x *RevokeTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_astraios_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokensRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokensRequest) Descriptor() ([]byte, []int) {
+	return file_astraios_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RevokeTokensRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 // 注册请求
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -184,7 +274,7 @@ type RegisterRequest struct {
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_astraios_auth_proto_msgTypes[3]
+	mi := &file_astraios_auth_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -196,7 +286,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_astraios_auth_proto_msgTypes[3]
+	mi := &file_astraios_auth_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,7 +299,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_astraios_auth_proto_rawDescGZIP(), []int{3}
+	return file_astraios_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RegisterRequest) GetUsername() string {
@@ -242,7 +332,7 @@ type RegisterResponse struct {
 
 func (x *RegisterResponse) Reset() {
 	*x = RegisterResponse{}
-	mi := &file_astraios_auth_proto_msgTypes[4]
+	mi := &file_astraios_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +344,7 @@ func (x *RegisterResponse) String() string {
 func (*RegisterResponse) ProtoMessage() {}
 
 func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_astraios_auth_proto_msgTypes[4]
+	mi := &file_astraios_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +357,7 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
 func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_astraios_auth_proto_rawDescGZIP(), []int{4}
+	return file_astraios_auth_proto_rawDescGZIP(), []int{6}
 }
 
 // 刷新令牌请求
@@ -280,7 +370,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_astraios_auth_proto_msgTypes[5]
+	mi := &file_astraios_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +382,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_astraios_auth_proto_msgTypes[5]
+	mi := &file_astraios_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +395,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_astraios_auth_proto_rawDescGZIP(), []int{5}
+	return file_astraios_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -326,7 +416,7 @@ type RefreshTokenResponse struct {
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_astraios_auth_proto_msgTypes[6]
+	mi := &file_astraios_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +428,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_astraios_auth_proto_msgTypes[6]
+	mi := &file_astraios_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +441,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_astraios_auth_proto_rawDescGZIP(), []int{6}
+	return file_astraios_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RefreshTokenResponse) GetAccessToken() string {
@@ -370,20 +460,24 @@ func (x *RefreshTokenResponse) GetRefreshToken() string {
 
 // 单个 JWK
 type Jwk struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Kty           string                 `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
-	Use           string                 `protobuf:"bytes,2,opt,name=use,proto3" json:"use,omitempty"`
-	Kid           string                 `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
-	Alg           string                 `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
-	N             string                 `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
-	E             string                 `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kty   string                 `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	Use   string                 `protobuf:"bytes,2,opt,name=use,proto3" json:"use,omitempty"`
+	Kid   string                 `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	Alg   string                 `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	N     string                 `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	E     string                 `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	// EC (P-256/P-384/P-521) 与 OKP (Ed25519) 密钥参数
+	Crv           string `protobuf:"bytes,7,opt,name=crv,proto3" json:"crv,omitempty"`
+	X             string `protobuf:"bytes,8,opt,name=x,proto3" json:"x,omitempty"`
+	Y             string `protobuf:"bytes,9,opt,name=y,proto3" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Jwk) Reset() {
 	*x = Jwk{}
-	mi := &file_astraios_auth_proto_msgTypes[7]
+	mi := &file_astraios_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +489,7 @@ func (x *Jwk) String() string {
 func (*Jwk) ProtoMessage() {}
 
 func (x *Jwk) ProtoReflect() protoreflect.Message {
-	mi := &file_astraios_auth_proto_msgTypes[7]
+	mi := &file_astraios_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +502,7 @@ func (x *Jwk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Jwk.ProtoReflect.Descriptor instead.
 func (*Jwk) Descriptor() ([]byte, []int) {
-	return file_astraios_auth_proto_rawDescGZIP(), []int{7}
+	return file_astraios_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Jwk) GetKty() string {
@@ -453,6 +547,27 @@ func (x *Jwk) GetE() string {
 	return ""
 }
 
+func (x *Jwk) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *Jwk) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *Jwk) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
 // JWKS 响应
 type JwksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -463,7 +578,7 @@ type JwksResponse struct {
 
 func (x *JwksResponse) Reset() {
 	*x = JwksResponse{}
-	mi := &file_astraios_auth_proto_msgTypes[8]
+	mi := &file_astraios_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -475,7 +590,7 @@ func (x *JwksResponse) String() string {
 func (*JwksResponse) ProtoMessage() {}
 
 func (x *JwksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_astraios_auth_proto_msgTypes[8]
+	mi := &file_astraios_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +603,7 @@ func (x *JwksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JwksResponse.ProtoReflect.Descriptor instead.
 func (*JwksResponse) Descriptor() ([]byte, []int) {
-	return file_astraios_auth_proto_rawDescGZIP(), []int{8}
+	return file_astraios_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *JwksResponse) GetKeys() []*Jwk {
@@ -510,7 +625,11 @@ const file_astraios_auth_proto_rawDesc = "" +
 	"\x04type\x18\x03 \x01(\x05R\x04type\"n\n" +
 	"\rLoginResponse\x12!\n" +
 	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x04 \x01(\tR\frefreshTokenJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\x04codeR\x03msg\"]\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshTokenJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\x04codeR\x03msg\"I\n" +
+	"\x11IssueTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userIdJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04R\busernameR\x05roles\".\n" +
+	"\x13RevokeTokensRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"]\n" +
 	"\x0fRegisterRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
@@ -520,21 +639,27 @@ const file_astraios_auth_proto_rawDesc = "" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"u\n" +
 	"\x14RefreshTokenResponse\x12!\n" +
 	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x04 \x01(\tR\frefreshTokenJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\x04codeR\x03msg\"i\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshTokenJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\x04codeR\x03msg\"\x97\x01\n" +
 	"\x03Jwk\x12\x10\n" +
 	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
 	"\x03use\x18\x02 \x01(\tR\x03use\x12\x10\n" +
 	"\x03kid\x18\x03 \x01(\tR\x03kid\x12\x10\n" +
 	"\x03alg\x18\x04 \x01(\tR\x03alg\x12\f\n" +
 	"\x01n\x18\x05 \x01(\tR\x01n\x12\f\n" +
-	"\x01e\x18\x06 \x01(\tR\x01e\"6\n" +
+	"\x01e\x18\x06 \x01(\tR\x01e\x12\x10\n" +
+	"\x03crv\x18\a \x01(\tR\x03crv\x12\f\n" +
+	"\x01x\x18\b \x01(\tR\x01x\x12\f\n" +
+	"\x01y\x18\t \x01(\tR\x01y\"6\n" +
 	"\fJwksResponse\x12&\n" +
-	"\x04keys\x18\x01 \x03(\v2\x12.astraios.auth.JwkR\x04keys2\xb5\x02\n" +
+	"\x04keys\x18\x01 \x03(\v2\x12.astraios.auth.JwkR\x04keys2\xcd\x03\n" +
 	"\vAuthService\x12B\n" +
 	"\x05Login\x12\x1b.astraios.auth.LoginRequest\x1a\x1c.astraios.auth.LoginResponse\x12K\n" +
 	"\bRegister\x12\x1e.astraios.auth.RegisterRequest\x1a\x1f.astraios.auth.RegisterResponse\x12W\n" +
 	"\fRefreshToken\x12\".astraios.auth.RefreshTokenRequest\x1a#.astraios.auth.RefreshTokenResponse\x12<\n" +
-	"\aGetJwks\x12\x14.astraios.auth.Empty\x1a\x1b.astraios.auth.JwksResponseBT\n" +
+	"\aGetJwks\x12\x14.astraios.auth.Empty\x1a\x1b.astraios.auth.JwksResponse\x12L\n" +
+	"\n" +
+	"IssueToken\x12 .astraios.auth.IssueTokenRequest\x1a\x1c.astraios.auth.LoginResponse\x12H\n" +
+	"\fRevokeTokens\x12\".astraios.auth.RevokeTokensRequest\x1a\x14.astraios.auth.EmptyBT\n" +
 	"\x16com.astraios.grpc.authP\x01Z8github.com/GUET-BAT/Astraios-S/gateway-service/pb/authpbb\x06proto3"
 
 var (
@@ -549,33 +674,39 @@ func file_astraios_auth_proto_rawDescGZIP() []byte {
 	return file_astraios_auth_proto_rawDescData
 }
 
-var file_astraios_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_astraios_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_astraios_auth_proto_goTypes = []any{
 	(*Empty)(nil),                // 0: astraios.auth.Empty
 	(*LoginRequest)(nil),         // 1: astraios.auth.LoginRequest
 	(*LoginResponse)(nil),        // 2: astraios.auth.LoginResponse
-	(*RegisterRequest)(nil),      // 3: astraios.auth.RegisterRequest
-	(*RegisterResponse)(nil),     // 4: astraios.auth.RegisterResponse
-	(*RefreshTokenRequest)(nil),  // 5: astraios.auth.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil), // 6: astraios.auth.RefreshTokenResponse
-	(*Jwk)(nil),                  // 7: astraios.auth.Jwk
-	(*JwksResponse)(nil),         // 8: astraios.auth.JwksResponse
+	(*IssueTokenRequest)(nil),    // 3: astraios.auth.IssueTokenRequest
+	(*RevokeTokensRequest)(nil),  // 4: astraios.auth.RevokeTokensRequest
+	(*RegisterRequest)(nil),      // 5: astraios.auth.RegisterRequest
+	(*RegisterResponse)(nil),     // 6: astraios.auth.RegisterResponse
+	(*RefreshTokenRequest)(nil),  // 7: astraios.auth.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil), // 8: astraios.auth.RefreshTokenResponse
+	(*Jwk)(nil),                  // 9: astraios.auth.Jwk
+	(*JwksResponse)(nil),         // 10: astraios.auth.JwksResponse
 }
 var file_astraios_auth_proto_depIdxs = []int32{
-	7, // 0: astraios.auth.JwksResponse.keys:type_name -> astraios.auth.Jwk
-	1, // 1: astraios.auth.AuthService.Login:input_type -> astraios.auth.LoginRequest
-	3, // 2: astraios.auth.AuthService.Register:input_type -> astraios.auth.RegisterRequest
-	5, // 3: astraios.auth.AuthService.RefreshToken:input_type -> astraios.auth.RefreshTokenRequest
-	0, // 4: astraios.auth.AuthService.GetJwks:input_type -> astraios.auth.Empty
-	2, // 5: astraios.auth.AuthService.Login:output_type -> astraios.auth.LoginResponse
-	4, // 6: astraios.auth.AuthService.Register:output_type -> astraios.auth.RegisterResponse
-	6, // 7: astraios.auth.AuthService.RefreshToken:output_type -> astraios.auth.RefreshTokenResponse
-	8, // 8: astraios.auth.AuthService.GetJwks:output_type -> astraios.auth.JwksResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: astraios.auth.JwksResponse.keys:type_name -> astraios.auth.Jwk
+	1,  // 1: astraios.auth.AuthService.Login:input_type -> astraios.auth.LoginRequest
+	5,  // 2: astraios.auth.AuthService.Register:input_type -> astraios.auth.RegisterRequest
+	7,  // 3: astraios.auth.AuthService.RefreshToken:input_type -> astraios.auth.RefreshTokenRequest
+	0,  // 4: astraios.auth.AuthService.GetJwks:input_type -> astraios.auth.Empty
+	3,  // 5: astraios.auth.AuthService.IssueToken:input_type -> astraios.auth.IssueTokenRequest
+	4,  // 6: astraios.auth.AuthService.RevokeTokens:input_type -> astraios.auth.RevokeTokensRequest
+	2,  // 7: astraios.auth.AuthService.Login:output_type -> astraios.auth.LoginResponse
+	6,  // 8: astraios.auth.AuthService.Register:output_type -> astraios.auth.RegisterResponse
+	8,  // 9: astraios.auth.AuthService.RefreshToken:output_type -> astraios.auth.RefreshTokenResponse
+	10, // 10: astraios.auth.AuthService.GetJwks:output_type -> astraios.auth.JwksResponse
+	2,  // 11: astraios.auth.AuthService.IssueToken:output_type -> astraios.auth.LoginResponse
+	0,  // 12: astraios.auth.AuthService.RevokeTokens:output_type -> astraios.auth.Empty
+	7,  // [7:13] is the sub-list for method output_type
+	1,  // [1:7] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_astraios_auth_proto_init() }
@@ -589,7 +720,7 @@ func file_astraios_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_astraios_auth_proto_rawDesc), len(file_astraios_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,8 @@ const (
 	AuthService_Register_FullMethodName     = "/astraios.auth.AuthService/Register"
 	AuthService_RefreshToken_FullMethodName = "/astraios.auth.AuthService/RefreshToken"
 	AuthService_GetJwks_FullMethodName      = "/astraios.auth.AuthService/GetJwks"
+	AuthService_IssueToken_FullMethodName   = "/astraios.auth.AuthService/IssueToken"
+	AuthService_RevokeTokens_FullMethodName = "/astraios.auth.AuthService/RevokeTokens"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -39,6 +41,10 @@ type AuthServiceClient interface {
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	// 获取 JWKS (/.well-known/jwks.json)
 	GetJwks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*JwksResponse, error)
+	// 为已由其他方式认证的用户签发令牌（如第三方登录），仅供内部服务调用，需携带 x-internal-token 元数据
+	IssueToken(ctx context.Context, in *IssueTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// 吊销用户的刷新令牌（如注销账号），仅供内部服务调用，需携带 x-internal-token 元数据
+	RevokeTokens(ctx context.Context, in *RevokeTokensRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type authServiceClient struct {
@@ -89,6 +95,26 @@ func (c *authServiceClient) GetJwks(ctx context.Context, in *Empty, opts ...grpc
 	return out, nil
 }
 
+func (c *authServiceClient) IssueToken(ctx context.Context, in *IssueTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_IssueToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeTokens(ctx context.Context, in *RevokeTokensRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AuthService_RevokeTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -103,6 +129,10 @@ type AuthServiceServer interface {
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	// 获取 JWKS (/.well-known/jwks.json)
 	GetJwks(context.Context, *Empty) (*JwksResponse, error)
+	// 为已由其他方式认证的用户签发令牌（如第三方登录），仅供内部服务调用，需携带 x-internal-token 元数据
+	IssueToken(context.Context, *IssueTokenRequest) (*LoginResponse, error)
+	// 吊销用户的刷新令牌（如注销账号），仅供内部服务调用，需携带 x-internal-token 元数据
+	RevokeTokens(context.Context, *RevokeTokensRequest) (*Empty, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -125,6 +155,12 @@ func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshToke
 func (UnimplementedAuthServiceServer) GetJwks(context.Context, *Empty) (*JwksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJwks not implemented")
 }
+func (UnimplementedAuthServiceServer) IssueToken(context.Context, *IssueTokenRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeTokens(context.Context, *RevokeTokensRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTokens not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -218,6 +254,42 @@ func _AuthService_GetJwks_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_IssueToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).IssueToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_IssueToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).IssueToken(ctx, req.(*IssueTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeTokens(ctx, req.(*RevokeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +313,14 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetJwks",
 			Handler:    _AuthService_GetJwks_Handler,
 		},
+		{
+			MethodName: "IssueToken",
+			Handler:    _AuthService_IssueToken_Handler,
+		},
+		{
+			MethodName: "RevokeTokens",
+			Handler:    _AuthService_RevokeTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "astraios_auth.proto",
@@ -1,10 +1,16 @@
 package httpstatuscode
 
 const (
-	CodeSuccess       = 0
-	CodeInvalidParam  = 1001
-	CodeUnanthorized  = 2401
-	CodeAlreadyExists = 3001
-	CodeUnlogin       = 3002
-	CodeInternalError = 4001
+	CodeSuccess         = 0
+	CodeInvalidParam    = 1001
+	CodeUnanthorized    = 2401
+	CodeForbidden       = 2403
+	CodeTooManyRequests = 2429
+	CodeAlreadyExists   = 3001
+	CodeUnlogin         = 3002
+	CodeNotFound        = 3004
+	CodeInternalError   = 4001
+	CodeUnavailable     = 4003
+	CodeTimeout         = 4004
+	CodeNotImplemented  = 4005
 )
@@ -58,7 +58,9 @@ if ($ApiFiles.Count -gt 1) {
 $Arguments = @(
     "api", "go",
     "--api=$ApiFile",
-    "--dir=$ServiceDir"
+    "--dir=$ServiceDir",
+    # 自定义模板：handler 将请求解析错误包装为 400，其余非 gRPC status 错误按 500 处理
+    "--home=$(Join-Path $ScriptDir "goctl-template")"
 )
 
 Write-Host "执行命令: goctl $Arguments"
@@ -46,8 +46,10 @@ if [ "$FILE_COUNT" -gt 1 ]; then
     echo "⚠️  发现多个 .api 文件，默认使用: $API_FILE"
 fi
 
+# 自定义模板：handler 将请求解析错误包装为 400，其余非 gRPC status 错误按 500 处理
 goctl api go \
   --api="$API_FILE" \
-  --dir="$SERVICE_DIR"
+  --dir="$SERVICE_DIR" \
+  --home="${SCRIPT_DIR}/goctl-template"
 
 echo "✅ HTTP API 服务端代码生成完成！"
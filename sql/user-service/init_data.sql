@@ -84,6 +84,10 @@ INSERT INTO `t_user_settings` (`user_id`, `privacy_level`, `allow_stranger_msg`,
     }
 }');
 
+-- 测试用户1的角色（管理员需在部署后手动授予，种子数据不包含）
+INSERT INTO `t_user_role` (`user_id`, `role`) VALUES
+(1891234567890123456, 'user');
+
 -- =====================================================
 -- 存储过程：创建新用户（事务保证数据一致性）
 -- =====================================================
@@ -0,0 +1,69 @@
+-- =====================================================
+-- Astraios 用户服务增量迁移 001
+-- 适用于按旧版 schema.sql 建库的已有环境，新建库直接执行 schema.sql 即可
+-- 变更内容:
+--   t_user 新增 password_set、purged_at 字段及 idx_deleted_at 索引
+--   新增 t_user_oauth、t_user_role、t_user_follow 表
+-- 注意: ALTER TABLE 语句不可重复执行，请仅执行一次
+-- =====================================================
+
+USE `astraios_user`;
+
+-- =====================================================
+-- 1. 用户主表 (t_user)
+-- 说明: password_set 默认 1，已有用户的密码均由用户设置
+-- =====================================================
+ALTER TABLE `t_user`
+    ADD COLUMN `password_set` TINYINT UNSIGNED NOT NULL DEFAULT 1 COMMENT '密码是否由用户设置：0-随机密码（第三方登录创建），1-已设置' AFTER `password`,
+    ADD COLUMN `purged_at` DATETIME DEFAULT NULL COMMENT '数据清除时间（注销宽限期结束后匿名化）' AFTER `deleted_at`,
+    ADD KEY `idx_deleted_at` (`deleted_at`);
+
+-- =====================================================
+-- 2. 第三方登录绑定表 (t_user_oauth)
+-- =====================================================
+CREATE TABLE IF NOT EXISTS `t_user_oauth` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    `platform` VARCHAR(20) NOT NULL COMMENT '第三方平台：wechat-微信，qq-QQ，weibo-微博，apple-苹果，google-谷歌',
+    `openid` VARCHAR(100) NOT NULL COMMENT '第三方平台用户唯一标识',
+    `unionid` VARCHAR(100) DEFAULT NULL COMMENT '第三方平台统一ID（如微信unionid）',
+    `nickname` VARCHAR(100) DEFAULT NULL COMMENT '第三方平台昵称',
+    `avatar` VARCHAR(500) DEFAULT NULL COMMENT '第三方平台头像',
+    `access_token` VARCHAR(500) DEFAULT NULL COMMENT '访问令牌',
+    `refresh_token` VARCHAR(500) DEFAULT NULL COMMENT '刷新令牌',
+    `token_expires_at` DATETIME DEFAULT NULL COMMENT '令牌过期时间',
+    `raw_data` JSON DEFAULT NULL COMMENT '原始返回数据（JSON格式）',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '首次绑定时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_platform_openid` (`platform`, `openid`),
+    UNIQUE KEY `uk_platform_unionid` (`platform`, `unionid`),
+    KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='第三方登录绑定表';
+
+-- =====================================================
+-- 3. 用户角色表 (t_user_role)
+-- =====================================================
+CREATE TABLE IF NOT EXISTS `t_user_role` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    `role` VARCHAR(32) NOT NULL COMMENT '角色：user-普通用户，admin-管理员',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '授予时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_role` (`user_id`, `role`),
+    KEY `idx_role` (`role`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户角色表';
+
+-- =====================================================
+-- 4. 用户关注关系表 (t_user_follow)
+-- =====================================================
+CREATE TABLE IF NOT EXISTS `t_user_follow` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID（分页游标）',
+    `follower_id` BIGINT UNSIGNED NOT NULL COMMENT '关注者ID',
+    `followee_id` BIGINT UNSIGNED NOT NULL COMMENT '被关注者ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '关注时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_follower_followee` (`follower_id`, `followee_id`),
+    KEY `idx_follower_id` (`follower_id`, `id`),
+    KEY `idx_followee_id` (`followee_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户关注关系表';
@@ -3,6 +3,7 @@
 -- 数据库类型: MySQL 8.0+
 -- 字符集: utf8mb4
 -- 排序规则: utf8mb4_unicode_ci
+-- 已有环境升级请执行对应的 migration_*.sql
 -- =====================================================
 
 -- 创建数据库（如不存在）
@@ -21,13 +22,16 @@ CREATE TABLE `t_user` (
     `id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID（雪花算法生成）',
     `username` VARCHAR(50) NOT NULL COMMENT '用户名（唯一标识，用于登录）',
     `password` VARCHAR(255) NOT NULL COMMENT '密码（BCrypt加密存储）',
+    `password_set` TINYINT UNSIGNED NOT NULL DEFAULT 1 COMMENT '密码是否由用户设置：0-随机密码（第三方登录创建），1-已设置',
     `status` TINYINT UNSIGNED NOT NULL DEFAULT 1 COMMENT '账户状态：0-禁用，1-正常，2-待激活，3-已注销',
     `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     `deleted_at` DATETIME DEFAULT NULL COMMENT '删除时间（软删除）',
+    `purged_at` DATETIME DEFAULT NULL COMMENT '数据清除时间（注销宽限期结束后匿名化）',
     PRIMARY KEY (`id`),
     UNIQUE KEY `uk_username` (`username`),
     KEY `idx_status` (`status`),
+    KEY `idx_deleted_at` (`deleted_at`),
     KEY `idx_created_at` (`created_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户主表';
 
@@ -155,6 +159,39 @@ CREATE TABLE `t_user_settings` (
     PRIMARY KEY (`user_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户设置表';
 
+-- =====================================================
+-- 7. 用户角色表 (t_user_role)
+-- 说明: 存储用户角色，登录时写入访问令牌供网关鉴权
+--       未配置任何角色的用户默认视为 user
+-- =====================================================
+-- DROP TABLE IF EXISTS `t_user_role`;
+CREATE TABLE `t_user_role` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    `role` VARCHAR(32) NOT NULL COMMENT '角色：user-普通用户，admin-管理员',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '授予时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_role` (`user_id`, `role`),
+    KEY `idx_role` (`role`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户角色表';
+
+-- =====================================================
+-- 8. 用户关注关系表 (t_user_follow)
+-- 说明: 存储关注关系，计数冗余在 t_user_stats 中并在同一事务内维护
+--       自增主键作为列表的分页游标
+-- =====================================================
+-- DROP TABLE IF EXISTS `t_user_follow`;
+CREATE TABLE `t_user_follow` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID（分页游标）',
+    `follower_id` BIGINT UNSIGNED NOT NULL COMMENT '关注者ID',
+    `followee_id` BIGINT UNSIGNED NOT NULL COMMENT '被关注者ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '关注时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_follower_followee` (`follower_id`, `followee_id`),
+    KEY `idx_follower_id` (`follower_id`, `id`),
+    KEY `idx_followee_id` (`followee_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户关注关系表';
+
 -- =====================================================
 -- 外键约束（可选，根据实际需求决定是否启用）
 -- 在微服务架构中，通常不使用外键以提升性能和灵活性
@@ -170,3 +207,7 @@ CREATE TABLE `t_user_settings` (
 --     FOREIGN KEY (`user_id`) REFERENCES `t_user`(`id`) ON DELETE CASCADE;
 -- ALTER TABLE `t_user_settings` ADD CONSTRAINT `fk_settings_user` 
 --     FOREIGN KEY (`user_id`) REFERENCES `t_user`(`id`) ON DELETE CASCADE;
+-- ALTER TABLE `t_user_role` ADD CONSTRAINT `fk_role_user` 
+--     FOREIGN KEY (`user_id`) REFERENCES `t_user`(`id`) ON DELETE CASCADE;
+-- ALTER TABLE `t_user_follow` ADD CONSTRAINT `fk_follow_follower`
+--     FOREIGN KEY (`follower_id`) REFERENCES `t_user`(`id`) ON DELETE CASCADE;
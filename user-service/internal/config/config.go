@@ -1,17 +1,29 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	zrpc.RpcServerConf
-	CommonService zrpc.RpcClientConf
-	ConfigDataId  string          `json:",optional"`
-	Mysql         MysqlConf       `json:"mysql,optional"`
-	CacheRedis    redis.RedisConf `json:"cacheRedis,optional"`
-	Oss           OssConf         `json:"oss,optional"`
+	CommonService  zrpc.RpcClientConf
+	ConfigDataId   string             `json:",optional"`
+	Mysql          MysqlConf          `json:"mysql,optional"`
+	CacheRedis     redis.RedisConf    `json:"cacheRedis,optional"`
+	Oss            OssConf            `json:"oss,optional"`
+	Storage        StorageConf        `json:"storage,optional"`
+	Lockout        LockoutConf        `json:"lockout,optional"`
+	Verification   VerificationConf   `json:"verification"`
+	ProfileCache   ProfileCacheConf   `json:"profileCache,optional"`
+	Account        AccountConf        `json:"account,optional"`
+	PasswordReset  PasswordResetConf  `json:"passwordReset,optional"`
+	PasswordPolicy PasswordPolicyConf `json:"passwordPolicy,optional"`
+	UsernamePolicy UsernamePolicyConf `json:"usernamePolicy,optional"`
+	Avatar         AvatarConf         `json:"avatar,optional"`
+	Background     BackgroundConf     `json:"background,optional"`
 }
 
 // MysqlConf holds read/write split MySQL configuration.
@@ -24,6 +36,9 @@ type MysqlConf struct {
 	Password string `json:"password"`
 	Database string `json:"database"`
 	Params   string `json:"params"`
+	// ReadAfterWriteSeconds is how long a user's reads go to the write port after that user's
+	// data changes, so they see their own writes despite replication lag. 0 disables it.
+	ReadAfterWriteSeconds int `json:"readAfterWriteSeconds,default=5"`
 }
 
 type OssConf struct {
@@ -34,3 +49,236 @@ type OssConf struct {
 	AccessKeyID     string `json:"accessKeyId"`
 	AccessKeySecret string `json:"accessKeySecret"`
 }
+
+// ImageExtensions lists the image types a media slot's ContentTypes may contain, which are the
+// ones the avatar processor can decode, and the extension an upload of that type is stored with.
+var ImageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
+// MediaLimits bounds the uploads accepted for one media slot. SetUserMedia refuses to sign an
+// upload outside them and ConfirmUserMedia checks the stored object against them again.
+type MediaLimits struct {
+	MaxBytes int64
+	// ContentTypes is the allowlist of image types; each must appear in ImageExtensions.
+	ContentTypes []string
+	// MaxPixels rejects images whose width times height is larger, before they are decoded.
+	MaxPixels int64
+}
+
+func (l MediaLimits) validate(section string) error {
+	if l.MaxBytes <= 0 {
+		return fmt.Errorf("%s.maxBytes must be positive, got %d", section, l.MaxBytes)
+	}
+	if l.MaxPixels <= 0 {
+		return fmt.Errorf("%s.maxPixels must be positive, got %d", section, l.MaxPixels)
+	}
+	if len(l.ContentTypes) == 0 {
+		return fmt.Errorf("%s.contentTypes must not be empty", section)
+	}
+	for _, contentType := range l.ContentTypes {
+		if _, ok := ImageExtensions[contentType]; !ok {
+			return fmt.Errorf("%s.contentTypes: unsupported type %q", section, contentType)
+		}
+	}
+	return nil
+}
+
+// AvatarConf limits avatar uploads and controls the job that processes confirmed uploads: avatars
+// become square variants and backgrounds are re-encoded.
+type AvatarConf struct {
+	MaxBytes     int64    `json:"maxBytes,default=5242880"`
+	ContentTypes []string `json:"contentTypes,default=[image/jpeg,image/png,image/webp]"`
+	// MaxPixels rejects images whose width times height is larger, before they are decoded.
+	MaxPixels int64 `json:"maxPixels,default=40000000"`
+	// ProcessIntervalSeconds is how often the processing job drains the queue of confirmed
+	// avatar and background uploads. 0 disables the job on this instance.
+	ProcessIntervalSeconds int `json:"processIntervalSeconds,default=2"`
+	// ProcessBatchSize caps how many uploads one run processes.
+	ProcessBatchSize int `json:"processBatchSize,default=20"`
+}
+
+func (c AvatarConf) Limits() MediaLimits {
+	return MediaLimits{MaxBytes: c.MaxBytes, ContentTypes: c.ContentTypes, MaxPixels: c.MaxPixels}
+}
+
+func (c AvatarConf) Validate() error {
+	if err := c.Limits().validate("avatar"); err != nil {
+		return err
+	}
+	if c.ProcessIntervalSeconds > 0 && c.ProcessBatchSize <= 0 {
+		return fmt.Errorf("avatar.processBatchSize must be positive, got %d", c.ProcessBatchSize)
+	}
+	return nil
+}
+
+// BackgroundConf limits uploads of the profile background image.
+type BackgroundConf struct {
+	MaxBytes     int64    `json:"maxBytes,default=10485760"`
+	ContentTypes []string `json:"contentTypes,default=[image/jpeg,image/png,image/webp]"`
+	// MaxPixels rejects images whose width times height is larger, before they are decoded.
+	MaxPixels int64 `json:"maxPixels,default=40000000"`
+}
+
+func (c BackgroundConf) Limits() MediaLimits {
+	return MediaLimits{MaxBytes: c.MaxBytes, ContentTypes: c.ContentTypes, MaxPixels: c.MaxPixels}
+}
+
+func (c BackgroundConf) Validate() error {
+	return c.Limits().validate("background")
+}
+
+// StorageConf selects where uploaded images are kept. Driver is "oss" (the Oss section),
+// "s3" for any S3-compatible service, or "local" to keep files on disk for development.
+type StorageConf struct {
+	Driver string           `json:"driver,default=oss"`
+	S3     S3Conf           `json:"s3,optional"`
+	Local  LocalStorageConf `json:"local,optional"`
+}
+
+type S3Conf struct {
+	Region          string `json:"region,optional"`
+	Bucketname      string `json:"bucketname,optional"`
+	Endpoint        string `json:"endpoint,optional"`
+	AccessKeyID     string `json:"accessKeyId,optional"`
+	AccessKeySecret string `json:"accessKeySecret,optional"`
+	// UsePathStyle is needed by most self-hosted servers such as MinIO.
+	UsePathStyle bool `json:"usePathStyle,optional"`
+}
+
+// LocalStorageConf configures the "local" driver. Presigned URLs are served by an HTTP listener
+// inside user-service on ListenOn, and BaseURL is how clients reach it.
+type LocalStorageConf struct {
+	Root     string `json:"root,default=data/objects"`
+	ListenOn string `json:"listenOn,default=0.0.0.0:8081"`
+	BaseURL  string `json:"baseUrl,default=http://localhost:8081"`
+	// Secret signs URLs; set it when several instances share Root.
+	Secret         string `json:"secret,optional"`
+	MaxUploadBytes int64  `json:"maxUploadBytes,default=10485760"`
+}
+
+// LockoutConf controls the progressive lockout applied after repeated failed password checks.
+// Once MaxAttempts failures accumulate within WindowSeconds, the username is locked for
+// BaseSeconds, doubling with every further failure up to MaxSeconds.
+type LockoutConf struct {
+	MaxAttempts   int `json:"maxAttempts,default=5"`
+	WindowSeconds int `json:"windowSeconds,default=900"`
+	BaseSeconds   int `json:"baseSeconds,default=60"`
+	MaxSeconds    int `json:"maxSeconds,default=3600"`
+}
+
+// VerificationConf controls one-time codes sent when binding a phone or email. Its sender also
+// delivers password reset tokens.
+type VerificationConf struct {
+	// Sender selects the CodeSender implementation and has no default, so every deployment picks
+	// one: "http" posts to SenderURL, "log" writes codes to the log and is refused outside dev and
+	// test mode.
+	Sender string `json:"sender,options=log|http"`
+	// SenderURL is the delivery endpoint of the "http" sender, required when Sender is "http".
+	SenderURL      string `json:"senderUrl,optional"`
+	CodeTTLSeconds int    `json:"codeTtlSeconds,default=600"`
+	// ResendSeconds is the minimum gap between two codes for the same identifier.
+	ResendSeconds int `json:"resendSeconds,default=60"`
+	// MaxAttempts is how many wrong codes are accepted before the code is discarded.
+	MaxAttempts int `json:"maxAttempts,default=5"`
+}
+
+// ProfileCacheConf controls the Redis read-through cache in front of t_user_profile.
+// Missing profiles are cached for NotFoundTTLSeconds so unknown ids do not hit MySQL repeatedly.
+type ProfileCacheConf struct {
+	Enabled            bool `json:"enabled,default=true"`
+	TTLSeconds         int  `json:"ttlSeconds,default=3600"`
+	NotFoundTTLSeconds int  `json:"notFoundTtlSeconds,default=60"`
+}
+
+// AccountConf controls account deactivation and the job that purges deactivated accounts.
+type AccountConf struct {
+	// GraceSeconds is how long a deactivated account can still be reactivated.
+	GraceSeconds int `json:"graceSeconds,default=2592000"`
+	// PurgeIntervalSeconds is how often the purge job looks for accounts past their grace period.
+	// 0 disables the job on this instance.
+	PurgeIntervalSeconds int `json:"purgeIntervalSeconds,default=3600"`
+	// PurgeBatchSize caps how many accounts one run purges.
+	PurgeBatchSize int `json:"purgeBatchSize,default=100"`
+}
+
+// PasswordResetConf controls the tokens sent by RequestPasswordReset.
+// Tokens are delivered by the Verification sender.
+type PasswordResetConf struct {
+	TokenTTLSeconds int `json:"tokenTtlSeconds,default=1800"`
+	// ResendSeconds is the minimum gap between two reset tokens for the same account.
+	ResendSeconds int `json:"resendSeconds,default=60"`
+}
+
+// Character classes accepted in PasswordPolicyConf.RequiredClasses.
+const (
+	ClassLetter = "letter"
+	ClassUpper  = "upper"
+	ClassLower  = "lower"
+	ClassDigit  = "digit"
+	ClassSymbol = "symbol"
+)
+
+// PasswordPolicyConf controls which new passwords Register, ChangePassword and ResetPassword accept.
+// Existing passwords are never re-checked.
+type PasswordPolicyConf struct {
+	// MinLength counts characters. Passwords longer than 72 bytes are always rejected, since bcrypt
+	// ignores the rest.
+	MinLength int `json:"minLength,default=8"`
+	// RequiredClasses lists the character classes every password must contain:
+	// letter, upper, lower, digit or symbol.
+	RequiredClasses []string `json:"requiredClasses,default=[letter,digit]"`
+	// DisallowUsername rejects passwords that contain the username, ignoring case.
+	DisallowUsername bool `json:"disallowUsername,default=true"`
+	// BreachedListFile is a local list of SHA-1 hashes of leaked passwords, one per line in the
+	// Pwned Passwords format (HASH or HASH:COUNT). Empty disables the check.
+	BreachedListFile string `json:"breachedListFile,optional"`
+}
+
+func (c PasswordPolicyConf) Validate() error {
+	if c.MinLength < 1 || c.MinLength > 72 {
+		return fmt.Errorf("passwordPolicy.minLength must be 1-72, got %d", c.MinLength)
+	}
+	for _, class := range c.RequiredClasses {
+		switch class {
+		case ClassLetter, ClassUpper, ClassLower, ClassDigit, ClassSymbol:
+		default:
+			return fmt.Errorf("passwordPolicy.requiredClasses: unknown class %q", class)
+		}
+	}
+	return nil
+}
+
+// Charsets accepted in UsernamePolicyConf.Charset.
+const (
+	CharsetASCII   = "ascii"
+	CharsetUnicode = "unicode"
+)
+
+// UsernamePolicyConf controls which usernames Register accepts. Usernames are NFKC-normalized
+// first, so full-width and other compatibility forms collapse to their plain equivalents.
+type UsernamePolicyConf struct {
+	MinLength int `json:"minLength,default=3"`
+	// MaxLength counts characters and may not exceed 32.
+	MaxLength int `json:"maxLength,default=32"`
+	// Charset is "ascii" for letters, digits, '_', '.' and '-' only, or "unicode" to allow letters
+	// and digits of any script. Unicode usernames may not mix Latin, Cyrillic and Greek letters,
+	// which is where most look-alike names come from.
+	Charset string `json:"charset,default=ascii"`
+	// Reserved usernames cannot be registered, compared without regard to case.
+	Reserved []string `json:"reserved,optional"`
+}
+
+func (c UsernamePolicyConf) Validate() error {
+	if c.MinLength < 1 || c.MaxLength < c.MinLength || c.MaxLength > 32 {
+		return fmt.Errorf("usernamePolicy: length must satisfy 1 <= minLength <= maxLength <= 32, got %d-%d",
+			c.MinLength, c.MaxLength)
+	}
+	if c.Charset != CharsetASCII && c.Charset != CharsetUnicode {
+		return fmt.Errorf("usernamePolicy.charset must be %q or %q, got %q", CharsetASCII, CharsetUnicode, c.Charset)
+	}
+	return nil
+}
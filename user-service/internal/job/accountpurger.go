@@ -0,0 +1,82 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/logic"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	accountPurgeLockKey = "user:job:account-purge"
+	// accountPurgeLockSeconds bounds one run; the lock frees itself if an instance dies mid-run.
+	accountPurgeLockSeconds = 600
+)
+
+// AccountPurger periodically purges accounts whose deactivation grace period has ended.
+// Every instance runs it; a redis lock lets only one of them purge at a time.
+type AccountPurger struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	lock     *redis.RedisLock
+	done     chan struct{}
+}
+
+func NewAccountPurger(svcCtx *svc.ServiceContext) *AccountPurger {
+	lock := redis.NewRedisLock(svcCtx.Redis, accountPurgeLockKey)
+	lock.SetExpire(accountPurgeLockSeconds)
+	return &AccountPurger{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Account.PurgeIntervalSeconds) * time.Second,
+		lock:     lock,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the purge loop until Stop is called.
+func (p *AccountPurger) Start() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.runOnce()
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *AccountPurger) Stop() {
+	close(p.done)
+}
+
+func (p *AccountPurger) runOnce() {
+	ctx := context.Background()
+	acquired, err := p.lock.AcquireCtx(ctx)
+	if err != nil {
+		logx.Errorf("account purge: acquire lock failed: %v", err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	defer func() {
+		if _, err := p.lock.ReleaseCtx(ctx); err != nil {
+			logx.Errorf("account purge: release lock failed: %v", err)
+		}
+	}()
+
+	purged, err := logic.PurgeExpiredAccounts(ctx, p.svcCtx)
+	if err != nil {
+		logx.Errorf("account purge: run failed: %v", err)
+		return
+	}
+	if purged > 0 {
+		logx.Infof("account purge: purged %d accounts", purged)
+	}
+}
@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/logic"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// MediaProcessor periodically renders confirmed avatar and background uploads.
+// Every instance runs it; the uploads come off a shared queue, so no lock is needed.
+type MediaProcessor struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	done     chan struct{}
+}
+
+func NewMediaProcessor(svcCtx *svc.ServiceContext) *MediaProcessor {
+	return &MediaProcessor{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Avatar.ProcessIntervalSeconds) * time.Second,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the processing loop until Stop is called.
+func (p *MediaProcessor) Start() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.runOnce()
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *MediaProcessor) Stop() {
+	close(p.done)
+}
+
+func (p *MediaProcessor) runOnce() {
+	processed, err := logic.ProcessQueuedMedia(context.Background(), p.svcCtx)
+	if err != nil {
+		logx.Errorf("media processing: run failed: %v", err)
+	}
+	if processed > 0 {
+		logx.Infof("media processing: processed %d uploads", processed)
+	}
+}
@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const purgedUsernamePrefix = "deleted_"
+
+// PurgeExpiredAccounts erases the data of accounts whose grace period has ended, at most
+// Account.PurgeBatchSize per call. It returns how many accounts were purged. An account that
+// fails is left for the next run.
+//
+// Sessions are not revoked here: the gateway revokes every access and refresh token before it
+// deactivates the account, and refuses to deactivate when that fails.
+func PurgeExpiredAccounts(ctx context.Context, svcCtx *svc.ServiceContext) (int, error) {
+	cfg := svcCtx.Config.Account
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	var userIDs []int64
+	if err := svcCtx.WriteConn.QueryRowsCtx(queryCtx, &userIDs,
+		`SELECT id FROM t_user
+		WHERE status = ? AND purged_at IS NULL AND deleted_at < NOW() - INTERVAL ? SECOND
+		ORDER BY deleted_at LIMIT ?`, StatusDeactivated, cfg.GraceSeconds, cfg.PurgeBatchSize); err != nil {
+		return 0, err
+	}
+
+	logger := logx.WithContext(ctx)
+	purged := 0
+	for _, userID := range userIDs {
+		if err := purgeAccount(ctx, svcCtx, userID); err != nil {
+			logger.Errorf("purge account failed, userId=%d: %v", userID, err)
+			continue
+		}
+		purged++
+		logger.Infof("purge account: success, userId=%d", userID)
+	}
+	return purged, nil
+}
+
+// purgeAccount locks the account row and re-checks that it is still due, then deletes the user's
+// uploaded images and, in the same transaction, anonymizes the profile and removes login methods,
+// roles, settings, stats and follow edges. The t_user row stays, renamed, so the id is never reused.
+// Holding the lock while objects are deleted keeps a racing reactivation from succeeding on an
+// account whose images are already gone; any failure rolls back and leaves the account for a retry.
+func purgeAccount(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) error {
+	if svcCtx.ObjectStore == nil {
+		return errStoreNotInitialized
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout+time.Duration(len(mediaSlots))*storeOpTimeout)
+	defer cancel()
+	err := svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		// Step 1: Re-check the state under lock.
+		var id int64
+		if err := session.QueryRowCtx(ctx, &id,
+			`SELECT id FROM t_user
+			WHERE id = ? AND status = ? AND purged_at IS NULL AND deleted_at < NOW() - INTERVAL ? SECOND
+			FOR UPDATE`, userID, StatusDeactivated, svcCtx.Config.Account.GraceSeconds); err != nil {
+			return err
+		}
+
+		// Step 2: Delete everything uploaded under {prefix}/{userId}/ of every media slot.
+		for _, slot := range mediaSlots {
+			storeCtx, storeCancel := context.WithTimeout(ctx, storeOpTimeout)
+			_, err := util.DeletePrefix(storeCtx, svcCtx.ObjectStore, slot.userPrefix(strconv.FormatInt(userID, 10)))
+			storeCancel()
+			if err != nil {
+				return err
+			}
+		}
+
+		// Step 3: Erase the account data.
+		statements := []string{
+			`UPDATE t_user_stats s JOIN t_user_follow f ON s.user_id = f.followee_id
+			SET s.follower_count = IF(s.follower_count > 0, s.follower_count - 1, 0) WHERE f.follower_id = ?`,
+			`UPDATE t_user_stats s JOIN t_user_follow f ON s.user_id = f.follower_id
+			SET s.following_count = IF(s.following_count > 0, s.following_count - 1, 0) WHERE f.followee_id = ?`,
+			`DELETE FROM t_user_follow WHERE follower_id = ?`,
+			`DELETE FROM t_user_follow WHERE followee_id = ?`,
+			`DELETE FROM t_user_auth WHERE user_id = ?`,
+			`DELETE FROM t_user_oauth WHERE user_id = ?`,
+			`DELETE FROM t_user_role WHERE user_id = ?`,
+			`DELETE FROM t_user_settings WHERE user_id = ?`,
+			`DELETE FROM t_user_stats WHERE user_id = ?`,
+		}
+		for _, statement := range statements {
+			if _, err := session.ExecCtx(ctx, statement, userID); err != nil {
+				return err
+			}
+		}
+		if _, err := session.ExecCtx(ctx,
+			`UPDATE t_user_profile SET nickname = NULL, avatar = ?, gender = 0, birthday = NULL, bio = NULL,
+			background_image = NULL, country = NULL, province = NULL, city = NULL, school = NULL, major = NULL,
+			graduation_year = NULL WHERE user_id = ?`, defaultAvatarKey, userID); err != nil {
+			return err
+		}
+		_, err := session.ExecCtx(ctx,
+			`UPDATE t_user SET username = ?, password = '', purged_at = NOW() WHERE id = ?`,
+			purgedUsernamePrefix+strconv.FormatInt(userID, 10), userID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			// Already purged by an overlapping run.
+			return nil
+		}
+		return err
+	}
+
+	invalidateProfile(ctx, svcCtx, userID)
+	return nil
+}
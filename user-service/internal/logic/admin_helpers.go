@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	defaultAdminPageSize = 20
+	maxAdminPageSize     = 100
+)
+
+const adminUserColumns = `u.id, u.username, p.nickname, u.status, u.created_at, u.deleted_at, u.purged_at`
+
+// adminUserRow is one account as shown to administrators.
+type adminUserRow struct {
+	ID        int64          `db:"id"`
+	Username  string         `db:"username"`
+	Nickname  sql.NullString `db:"nickname"`
+	Status    int32          `db:"status"`
+	CreatedAt sql.NullTime   `db:"created_at"`
+	DeletedAt sql.NullTime   `db:"deleted_at"`
+	PurgedAt  sql.NullTime   `db:"purged_at"`
+}
+
+func (r *adminUserRow) toProto() *userpb.AdminUser {
+	return &userpb.AdminUser{
+		UserId:    strconv.FormatInt(r.ID, 10),
+		Username:  r.Username,
+		Nickname:  nullString(r.Nickname),
+		Status:    r.Status,
+		CreatedAt: formatTime(r.CreatedAt),
+		DeletedAt: formatTime(r.DeletedAt),
+		Purged:    r.PurgedAt.Valid,
+	}
+}
+
+// queryAdminUser reads one account from conn. It returns sqlx.ErrNotFound when the id is unknown.
+func queryAdminUser(ctx context.Context, conn sqlx.SqlConn, userID int64) (*adminUserRow, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	var row adminUserRow
+	if err := conn.QueryRowCtx(queryCtx, &row, `SELECT `+adminUserColumns+`
+		FROM t_user u LEFT JOIN t_user_profile p ON p.user_id = u.id
+		WHERE u.id = ? LIMIT 1`, userID); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+// likePrefix turns user input into a LIKE pattern matching it as a literal prefix.
+func likePrefix(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value) + "%"
+}
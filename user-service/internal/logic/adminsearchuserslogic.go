@@ -0,0 +1,116 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminSearchUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAdminSearchUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminSearchUsersLogic {
+	return &AdminSearchUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AdminSearchUsers pages through accounts matching the filters, newest first. Admin only.
+func (l *AdminSearchUsersLogic) AdminSearchUsers(in *userpb.AdminSearchUsersRequest) (*userpb.AdminSearchUsersResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	var conditions []string
+	var args []any
+	if username := strings.TrimSpace(in.Username); username != "" {
+		conditions = append(conditions, `u.username LIKE ?`)
+		args = append(args, likePrefix(username))
+	}
+	if nickname := strings.TrimSpace(in.Nickname); nickname != "" {
+		conditions = append(conditions, `p.nickname LIKE ?`)
+		args = append(args, likePrefix(nickname))
+	}
+	if len(in.Statuses) > 0 {
+		placeholders := make([]string, 0, len(in.Statuses))
+		for _, st := range in.Statuses {
+			if st < StatusDisabled || st > StatusDeactivated {
+				return nil, invalidArgument("statuses", "unknown status "+strconv.Itoa(int(st)))
+			}
+			placeholders = append(placeholders, "?")
+			args = append(args, st)
+		}
+		conditions = append(conditions, `u.status IN (`+strings.Join(placeholders, ", ")+`)`)
+	}
+	if after := strings.TrimSpace(in.CreatedAfter); after != "" {
+		t, err := time.Parse(time.RFC3339, after)
+		if err != nil {
+			return nil, invalidArgument("created_after", "created_after must be RFC 3339")
+		}
+		conditions = append(conditions, `u.created_at >= ?`)
+		args = append(args, t)
+	}
+	if before := strings.TrimSpace(in.CreatedBefore); before != "" {
+		t, err := time.Parse(time.RFC3339, before)
+		if err != nil {
+			return nil, invalidArgument("created_before", "created_before must be RFC 3339")
+		}
+		conditions = append(conditions, `u.created_at < ?`)
+		args = append(args, t)
+	}
+	if cursor := strings.TrimSpace(in.Cursor); cursor != "" {
+		parsed, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil || parsed <= 0 {
+			return nil, invalidArgument("cursor", "invalid cursor")
+		}
+		conditions = append(conditions, `u.id < ?`)
+		args = append(args, parsed)
+	}
+	if in.Limit < 0 {
+		return nil, invalidArgument("limit", "limit must not be negative")
+	}
+	limit := defaultAdminPageSize
+	if in.Limit > 0 {
+		limit = min(int(in.Limit), maxAdminPageSize)
+	}
+
+	// Step 2: Query one extra row to know whether another page exists.
+	query := `SELECT ` + adminUserColumns + `
+		FROM t_user u LEFT JOIN t_user_profile p ON p.user_id = u.id`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, ` AND `)
+	}
+	query += ` ORDER BY u.id DESC LIMIT ?`
+	args = append(args, limit+1)
+
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var rows []adminUserRow
+	if err := l.svcCtx.ReadConn.QueryRowsCtx(queryCtx, &rows, query, args...); err != nil {
+		l.Errorf("admin search users: query failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 3: Build the page.
+	resp := &userpb.AdminSearchUsersResponse{}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		resp.NextCursor = strconv.FormatInt(rows[len(rows)-1].ID, 10)
+	}
+	resp.Users = make([]*userpb.AdminUser, 0, len(rows))
+	for i := range rows {
+		resp.Users = append(resp.Users, rows[i].toProto())
+	}
+	return resp, nil
+}
@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxBatchUserCards caps one BatchGetUserCards call so a single IN (...) stays cheap.
+const maxBatchUserCards = 100
+
+type BatchGetUserCardsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchGetUserCardsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchGetUserCardsLogic {
+	return &BatchGetUserCardsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BatchGetUserCards hydrates up to 100 user ids into nickname and avatar cards in one call.
+func (l *BatchGetUserCardsLogic) BatchGetUserCards(in *userpb.BatchGetUserCardsRequest) (*userpb.BatchGetUserCardsResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	if len(in.UserIds) > maxBatchUserCards {
+		return nil, invalidArgument("user_ids", fmt.Sprintf("at most %d user_ids per call", maxBatchUserCards))
+	}
+	if in.AvatarSize < 0 {
+		return nil, invalidArgument("avatar_size", "avatar_size must not be negative")
+	}
+	resp := &userpb.BatchGetUserCardsResponse{}
+	if len(in.UserIds) == 0 {
+		return resp, nil
+	}
+
+	// Step 2: Parse and dedupe ids; malformed ones are reported as missing.
+	ids := make([]int64, 0, len(in.UserIds))
+	seen := make(map[int64]bool, len(in.UserIds))
+	missing := make(map[string]bool)
+	for _, raw := range in.UserIds {
+		id, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+		if err != nil {
+			missing[raw] = true
+			continue
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	// Step 3: Load all cards with one query on the read replica.
+	type cardRow struct {
+		UserID   int64          `db:"id"`
+		Nickname sql.NullString `db:"nickname"`
+		Avatar   sql.NullString `db:"avatar"`
+	}
+	var rows []cardRow
+	if len(ids) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+		args := make([]any, 0, len(ids))
+		for _, id := range ids {
+			args = append(args, id)
+		}
+		queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+		defer cancel()
+		if err := l.svcCtx.ReadConn.QueryRowsCtx(queryCtx, &rows,
+			`SELECT u.id, p.nickname, p.avatar FROM t_user u
+			LEFT JOIN t_user_profile p ON p.user_id = u.id
+			WHERE u.id IN (`+placeholders+`) AND u.deleted_at IS NULL`, args...); err != nil {
+			l.Errorf("batch get user cards: query failed: %v", err)
+			return nil, errInternal
+		}
+	}
+
+	// Step 4: Presign every object-key avatar in one pass; URLs and empty values pass through.
+	cards := make(map[int64]*userpb.UserCard, len(rows))
+	keys := make([]string, 0, len(rows))
+	for _, row := range rows {
+		avatar := avatarForSize(strings.TrimSpace(row.Avatar.String), in.AvatarSize)
+		cards[row.UserID] = &userpb.UserCard{
+			UserId:    strconv.FormatInt(row.UserID, 10),
+			Nickname:  nullString(row.Nickname),
+			AvatarUrl: avatar,
+		}
+		if avatar != "" && !isHTTPURL(avatar) {
+			keys = append(keys, avatar)
+		}
+	}
+	if len(keys) > 0 {
+		urls := l.presignAvatars(keys)
+		for _, card := range cards {
+			if card.AvatarUrl != "" && !isHTTPURL(card.AvatarUrl) {
+				card.AvatarUrl = urls[card.AvatarUrl]
+			}
+		}
+	}
+
+	// Step 5: Answer in request order.
+	for _, raw := range in.UserIds {
+		id, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+		if err != nil {
+			continue
+		}
+		if card, ok := cards[id]; ok {
+			resp.Cards = append(resp.Cards, card)
+		} else {
+			missing[raw] = true
+		}
+	}
+	for _, raw := range in.UserIds {
+		if missing[raw] {
+			resp.MissingUserIds = append(resp.MissingUserIds, raw)
+			delete(missing, raw)
+		}
+	}
+	return resp, nil
+}
+
+// presignAvatars signs keys in bulk. Failures are logged and leave those avatars empty.
+func (l *BatchGetUserCardsLogic) presignAvatars(keys []string) map[string]string {
+	if l.svcCtx.ObjectStore == nil {
+		l.Errorf("batch get user cards: object store not initialized")
+		return nil
+	}
+	storeCtx, cancel := context.WithTimeout(l.ctx, storeOpTimeout)
+	defer cancel()
+	urls, err := util.PresignGetBatch(storeCtx, l.svcCtx.ObjectStore, keys, mediaDisplayExpiry)
+	if err != nil {
+		l.Errorf("batch get user cards: presign avatars failed: %v", err)
+	}
+	return urls
+}
@@ -0,0 +1,146 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"google.golang.org/grpc/codes"
+)
+
+type BindIdentifierLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBindIdentifierLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindIdentifierLogic {
+	return &BindIdentifierLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BindIdentifier attaches an unverified phone or email to a user and sends a verification code.
+func (l *BindIdentifierLogic) BindIdentifier(in *userpb.IdentifierRequest) (*userpb.IdentifierResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	userID := strings.TrimSpace(in.UserId)
+	parsedID, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	identifier, err := normalizeIdentifier(in.AuthType, in.Identifier)
+	if err != nil {
+		return nil, invalidArgument("identifier", err.Error())
+	}
+
+	// Step 2: Make sure the identifier is free and the user has no verified one of this type.
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var owner struct {
+		UserID   int64 `db:"user_id"`
+		Verified int   `db:"verified"`
+	}
+	err = l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &owner,
+		`SELECT user_id, verified FROM t_user_auth WHERE auth_type = ? AND identifier = ? LIMIT 1`,
+		in.AuthType, identifier)
+	switch {
+	case err == nil:
+		if owner.Verified == 1 && owner.UserID == parsedID {
+			return &userpb.IdentifierResponse{AuthType: in.AuthType, Identifier: identifier, Verified: true}, nil
+		}
+		if owner.Verified == 1 {
+			return nil, errIdentifierTaken
+		}
+	case !errors.Is(err, sqlx.ErrNotFound):
+		l.Errorf("bind identifier: query identifier failed: %v", err)
+		return nil, errInternal
+	}
+
+	var verifiedCount int64
+	if err := l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &verifiedCount,
+		`SELECT COUNT(1) FROM t_user_auth WHERE user_id = ? AND auth_type = ? AND verified = 1`,
+		parsedID, in.AuthType); err != nil {
+		l.Errorf("bind identifier: query bound identifiers failed: %v", err)
+		return nil, errInternal
+	}
+	if verifiedCount > 0 {
+		return nil, newStatusError(codes.FailedPrecondition, ReasonIdentifierBound,
+			"unbind the current "+authChannel(in.AuthType)+" first")
+	}
+
+	// Step 3: Throttle code sends per identifier. The reservation comes first so the cooldown also
+	// throttles the binding writes below; it is released if no code goes out.
+	wait, err := reserveCodeSend(l.ctx, l.svcCtx, in.AuthType, identifier)
+	if err != nil {
+		l.Errorf("bind identifier: reserve code send failed: %v", err)
+		return nil, errInternal
+	}
+	if wait > 0 {
+		return nil, retryLater(ReasonCodeRecentlySent, "verification code sent recently", wait)
+	}
+	sent := false
+	defer func() {
+		if sent {
+			return
+		}
+		if err := releaseCodeSend(l.ctx, l.svcCtx, in.AuthType, identifier); err != nil {
+			l.Errorf("bind identifier: release code send failed: %v", err)
+		}
+	}()
+
+	// Step 4: Replace pending bindings with the new unverified one.
+	// Unverified claims by other users do not block the identifier.
+	err = l.svcCtx.WriteConn.TransactCtx(queryCtx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx,
+			`DELETE FROM t_user_auth WHERE verified = 0 AND ((user_id = ? AND auth_type = ?) OR (auth_type = ? AND identifier = ?))`,
+			parsedID, in.AuthType, in.AuthType, identifier); err != nil {
+			return err
+		}
+		_, err := session.ExecCtx(ctx,
+			`INSERT INTO t_user_auth (user_id, auth_type, identifier, verified) VALUES (?, ?, ?, 0)`,
+			parsedID, in.AuthType, identifier)
+		return err
+	})
+	if err != nil {
+		if isDuplicateKey(err) {
+			return nil, errIdentifierTaken
+		}
+		l.Errorf("bind identifier: insert binding failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 5: Generate, store and send the verification code.
+	code, err := newVerifyCode()
+	if err != nil {
+		l.Errorf("bind identifier: generate code failed: %v", err)
+		return nil, errInternal
+	}
+	if err := storeVerifyCode(l.ctx, l.svcCtx, in.AuthType, identifier, userID, code); err != nil {
+		l.Errorf("bind identifier: store code failed: %v", err)
+		return nil, errInternal
+	}
+	if err := l.svcCtx.CodeSender.Send(l.ctx, util.CodePurposeVerify, authChannel(in.AuthType), identifier, code); err != nil {
+		l.Errorf("bind identifier: send code failed: %v", err)
+		return nil, newStatusError(codes.Unavailable, ReasonInternal, "failed to send verification code")
+	}
+	sent = true
+
+	l.Infof("bind identifier: code sent, userId=%d authType=%d", parsedID, in.AuthType)
+	return &userpb.IdentifierResponse{
+		AuthType:      in.AuthType,
+		Identifier:    identifier,
+		CodeExpiresIn: int64(l.svcCtx.Config.Verification.CodeTTLSeconds),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"google.golang.org/grpc/codes"
+)
+
+type BindOAuthLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBindOAuthLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindOAuthLogic {
+	return &BindOAuthLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BindOAuth attaches a third-party identity to an existing user.
+func (l *BindOAuthLogic) BindOAuth(in *userpb.OAuthRequest) (*userpb.OAuthBindingsResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	identity, err := normalizeOAuthIdentity(in.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Make sure the identity is free and the user has no other account on this platform.
+	binding, err := findOAuthBinding(l.ctx, l.svcCtx.WriteConn, identity)
+	switch {
+	case err == nil:
+		if binding.UserID != parsedID {
+			return nil, errOAuthTaken
+		}
+		if err := refreshOAuthBinding(l.ctx, l.svcCtx.WriteConn, binding.ID, identity); err != nil {
+			l.Errorf("bind oauth: refresh binding failed: %v", err)
+		}
+		return l.list(parsedID)
+	case !errors.Is(err, sqlx.ErrNotFound):
+		l.Errorf("bind oauth: query binding failed: %v", err)
+		return nil, errInternal
+	}
+
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var boundCount int64
+	if err := l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &boundCount,
+		`SELECT COUNT(1) FROM t_user_oauth WHERE user_id = ? AND platform = ?`,
+		parsedID, identity.Platform); err != nil {
+		l.Errorf("bind oauth: query bound platforms failed: %v", err)
+		return nil, errInternal
+	}
+	if boundCount > 0 {
+		return nil, newStatusError(codes.FailedPrecondition, ReasonPlatformBound,
+			"unbind the current "+identity.Platform+" account first")
+	}
+
+	// Step 3: Insert the binding for a live user.
+	err = l.svcCtx.WriteConn.TransactCtx(queryCtx, func(ctx context.Context, session sqlx.Session) error {
+		var exists int64
+		if err := session.QueryRowCtx(ctx, &exists,
+			`SELECT COUNT(1) FROM t_user WHERE id = ? AND deleted_at IS NULL`, parsedID); err != nil {
+			return err
+		}
+		if exists == 0 {
+			return errUserNotFound
+		}
+		return insertOAuthBinding(ctx, session, parsedID, identity)
+	})
+	if err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, errUserNotFound
+		}
+		if isDuplicateKey(err) {
+			return nil, errOAuthTaken
+		}
+		l.Errorf("bind oauth: insert binding failed: %v", err)
+		return nil, errInternal
+	}
+
+	markWritten(l.ctx, l.svcCtx, parsedID)
+	l.Infof("bind oauth: success, userId=%d platform=%s", parsedID, identity.Platform)
+	return l.list(parsedID)
+}
+
+func (l *BindOAuthLogic) list(userID int64) (*userpb.OAuthBindingsResponse, error) {
+	resp, err := listOAuthBindings(l.ctx, l.svcCtx.WriteConn, userID)
+	if err != nil {
+		l.Errorf("bind oauth: list bindings failed: %v", err)
+		return nil, errInternal
+	}
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ChangePasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewChangePasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangePasswordLogic {
+	return &ChangePasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ChangePassword replaces user_id's password after checking the old one.
+func (l *ChangePasswordLogic) ChangePassword(in *userpb.ChangePasswordRequest) (*userpb.PasswordResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	if in.OldPassword == "" {
+		return nil, invalidArgument("old_password", "old_password is required")
+	}
+	if in.NewPassword == "" {
+		return nil, invalidArgument("new_password", "new_password is required")
+	}
+
+	// Step 2: Load the account.
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var record struct {
+		Username string `db:"username"`
+		Hash     string `db:"password"`
+	}
+	err = l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &record,
+		`SELECT username, password FROM t_user WHERE id = ? AND status = ? AND deleted_at IS NULL LIMIT 1`,
+		parsedID, StatusActive)
+	if err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			return nil, errUserNotFound
+		}
+		l.Errorf("change password: query failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 3: Check the old password under the login lockout, so a stolen session cannot be used
+	// to guess it.
+	subject := accountLockSubject(parsedID)
+	remaining, err := lockRemaining(l.ctx, l.svcCtx, subject)
+	if err != nil {
+		l.Errorf("change password: lockout check failed: %v", err)
+	} else if remaining > 0 {
+		return nil, accountLocked(remaining)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(record.Hash), []byte(in.OldPassword)) != nil {
+		l.Infof("change password: incorrect old password, userId=%d", parsedID)
+		if _, err := recordLoginFailure(l.ctx, l.svcCtx, subject); err != nil {
+			l.Errorf("change password: record failure failed: %v", err)
+		}
+		return nil, invalidArgument("old_password", "incorrect password")
+	}
+	if err := validatePassword(l.svcCtx, in.NewPassword, record.Username); err != nil {
+		return nil, invalidArgument("new_password", err.Error())
+	}
+	if bcrypt.CompareHashAndPassword([]byte(record.Hash), []byte(in.NewPassword)) == nil {
+		return nil, invalidArgument("new_password", "new password must differ from the old one")
+	}
+
+	// Step 4: Store the new password. A pending reset token would undo it, so drop that too.
+	if _, err := setPassword(l.ctx, l.svcCtx, parsedID, in.NewPassword); err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, errUserNotFound
+		}
+		l.Errorf("change password: update failed: %v", err)
+		return nil, errInternal
+	}
+	if err := discardResetToken(l.ctx, l.svcCtx, parsedID); err != nil {
+		l.Errorf("change password: discard reset token failed: %v", err)
+	}
+	if err := clearLoginFailures(l.ctx, l.svcCtx, subject); err != nil {
+		l.Errorf("change password: reset lockout failed: %v", err)
+	}
+
+	l.Infof("change password: success, userId=%d", parsedID)
+	return &userpb.PasswordResponse{UserId: strconv.FormatInt(parsedID, 10)}, nil
+}
@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmUserAvatarLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewConfirmUserAvatarLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmUserAvatarLogic {
+	return &ConfirmUserAvatarLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ConfirmUserAvatar is ConfirmUserMedia for the avatar, or for in.Slot when set.
+func (l *ConfirmUserAvatarLogic) ConfirmUserAvatar(in *userpb.UserAvatarRequest) (*userpb.UserAvatarResponse, error) {
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	resp, err := NewConfirmUserMediaLogic(l.ctx, l.svcCtx).ConfirmUserMedia(&userpb.UserMediaRequest{
+		UserId:    in.UserId,
+		Slot:      avatarRequestSlot(in),
+		ObjectKey: in.ObjectKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.UserAvatarResponse{ObjectKey: resp.ObjectKey, Processing: resp.Processing}, nil
+}
@@ -0,0 +1,172 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// sniffLen is how much of an upload http.DetectContentType looks at.
+const sniffLen = 512
+
+type ConfirmUserMediaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewConfirmUserMediaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmUserMediaLogic {
+	return &ConfirmUserMediaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ConfirmUserMedia queues the object uploaded through SetUserMedia for processing in the
+// request's media slot. It only replaces the user's current image once its variants exist, so the
+// response has processing set.
+func (l *ConfirmUserMediaLogic) ConfirmUserMedia(in *userpb.UserMediaRequest) (*userpb.UserMediaResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	userID := strings.TrimSpace(in.UserId)
+	parsedID, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	if strings.TrimSpace(in.Slot) == "" {
+		return nil, invalidArgument("slot", "slot is required")
+	}
+	slot, err := mediaSlotByName(in.Slot)
+	if err != nil {
+		return nil, err
+	}
+	objectKey := strings.TrimSpace(in.ObjectKey)
+	if objectKey == "" {
+		return nil, invalidArgument("object_key", "object_key is required")
+	}
+	if util.ValidateObjectName(objectKey) != nil || !strings.HasPrefix(objectKey, slot.userPrefix(userID)) {
+		return nil, invalidArgument("object_key", "invalid "+slot.name+" object key")
+	}
+	if l.svcCtx.ObjectStore == nil {
+		l.Errorf("confirm user media: object store not initialized")
+		return nil, errInternal
+	}
+
+	// Step 2: Only the upload handed out last by SetUserMedia can be confirmed.
+	pending, err := slot.pending(l.ctx, l.svcCtx, userID)
+	if err != nil {
+		l.Errorf("confirm user media: load pending upload failed: %v", err)
+		return nil, errInternal
+	}
+	if pending != objectKey {
+		return nil, errNoPendingUpload
+	}
+
+	// Step 3: Check the uploaded object. A rejected object is removed along with the session,
+	// so the client has to start over.
+	storeCtx, cancel := context.WithTimeout(l.ctx, storeOpTimeout)
+	defer cancel()
+	info, err := l.svcCtx.ObjectStore.Head(storeCtx, objectKey)
+	if err != nil {
+		if errors.Is(err, util.ErrObjectNotFound) {
+			return nil, errObjectNotUploaded
+		}
+		l.Errorf("confirm user media: head object failed: %v", err)
+		return nil, errInternal
+	}
+	violation, err := l.checkObject(slot, objectKey, info)
+	if err != nil {
+		if errors.Is(err, util.ErrObjectNotFound) {
+			return nil, errObjectNotUploaded
+		}
+		l.Errorf("confirm user media: read object failed: %v", err)
+		return nil, errInternal
+	}
+	if violation != "" {
+		l.Infof("confirm user media: rejected upload, userId=%s key=%s: %s", userID, objectKey, violation)
+		l.discardUpload(slot, userID, objectKey)
+		return nil, invalidArgument("object_key", violation)
+	}
+
+	// Step 4: Queue the upload for processing. The upload itself is never served, so the profile
+	// keeps its current image until the processor switches it to the variants.
+	if err := enqueueMediaProcessing(l.ctx, l.svcCtx, slot, mediaJob{UserID: parsedID, ObjectKey: objectKey}); err != nil {
+		l.Errorf("confirm user media: queue processing failed, key=%s: %v", objectKey, err)
+		return nil, errInternal
+	}
+	if err := slot.clearPending(l.ctx, l.svcCtx, userID); err != nil {
+		l.Errorf("confirm user media: clear pending upload failed: %v", err)
+	}
+	l.Infof("confirm user media: queued for processing, slot=%s userId=%s key=%s", slot.name, userID, objectKey)
+	return &userpb.UserMediaResponse{ObjectKey: objectKey, Processing: true}, nil
+}
+
+// checkObject returns why the object is not acceptable in slot, or "" when it is. The upload
+// policy already limited type and size; this catches policies signed under an older
+// configuration and, by sniffing the first bytes, content that does not match the type it was
+// declared as. The processor decodes the whole image later.
+func (l *ConfirmUserMediaLogic) checkObject(slot *mediaSlot, objectKey string, info *util.ObjectInfo) (string, error) {
+	limits := slot.limits(l.svcCtx.Config)
+	if info.Size <= 0 {
+		return "uploaded object is empty", nil
+	}
+	if info.Size > limits.MaxBytes {
+		return slot.name + " must be at most " + strconv.FormatInt(limits.MaxBytes, 10) + " bytes", nil
+	}
+	mediaType, _, err := mime.ParseMediaType(info.ContentType)
+	if err != nil || !slices.Contains(limits.ContentTypes, mediaType) {
+		return slot.name + " must be one of " + strings.Join(limits.ContentTypes, ", "), nil
+	}
+	sniffed, err := l.sniffContentType(objectKey)
+	if err != nil {
+		return "", err
+	}
+	if sniffed != mediaType {
+		return "uploaded object content does not match the declared type", nil
+	}
+	return "", nil
+}
+
+// sniffContentType detects the type of an object from its first bytes.
+func (l *ConfirmUserMediaLogic) sniffContentType(objectKey string) (string, error) {
+	storeCtx, cancel := context.WithTimeout(l.ctx, storeOpTimeout)
+	defer cancel()
+	body, err := l.svcCtx.ObjectStore.Get(storeCtx, objectKey)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return http.DetectContentType(head[:n]), nil
+}
+
+// discardUpload deletes a rejected object and its upload session. Failures are only logged: the
+// session expires on its own and the object is removed with the account at the latest.
+func (l *ConfirmUserMediaLogic) discardUpload(slot *mediaSlot, userID, objectKey string) {
+	storeCtx, cancel := context.WithTimeout(l.ctx, storeOpTimeout)
+	defer cancel()
+	if err := l.svcCtx.ObjectStore.Delete(storeCtx, objectKey); err != nil {
+		l.Errorf("confirm user media: delete rejected object failed, key=%s: %v", objectKey, err)
+	}
+	if err := slot.clearPending(l.ctx, l.svcCtx, userID); err != nil {
+		l.Errorf("confirm user media: clear pending upload failed: %v", err)
+	}
+}
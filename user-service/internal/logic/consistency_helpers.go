@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const recentWritePrefix = "user:rw:"
+
+func recentWriteKey(userID int64) string {
+	return recentWritePrefix + strconv.FormatInt(userID, 10)
+}
+
+// markWritten records that the data of userIDs just changed on the primary. Until the marker
+// expires, readConnFor sends reads of these users to WriteConn. Call it after the write commits.
+func markWritten(ctx context.Context, svcCtx *svc.ServiceContext, userIDs ...int64) {
+	seconds := svcCtx.Config.Mysql.ReadAfterWriteSeconds
+	if seconds <= 0 {
+		return
+	}
+	redisCtx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	for _, userID := range userIDs {
+		if err := svcCtx.Redis.SetexCtx(redisCtx, recentWriteKey(userID), "1", seconds); err != nil {
+			logx.WithContext(ctx).Errorf("mark recent write failed, userId=%d: %v", userID, err)
+		}
+	}
+}
+
+// readConnFor returns the connection to read userID's data from: WriteConn while the user has a
+// recent-write marker, ReadConn otherwise. If Redis cannot be reached the replica is used, so a
+// Redis outage does not move all read traffic onto the primary.
+func readConnFor(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) sqlx.SqlConn {
+	if svcCtx.Config.Mysql.ReadAfterWriteSeconds <= 0 {
+		return svcCtx.ReadConn
+	}
+	redisCtx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	written, err := svcCtx.Redis.ExistsCtx(redisCtx, recentWriteKey(userID))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("check recent write failed, userId=%d: %v", userID, err)
+		return svcCtx.ReadConn
+	}
+	if written {
+		return svcCtx.WriteConn
+	}
+	return svcCtx.ReadConn
+}
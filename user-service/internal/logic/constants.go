@@ -4,11 +4,42 @@ import "time"
 
 // Response codes used in user service RPC responses.
 const (
-	CodeSuccess      int32 = 0
-	CodeInvalidParam int32 = 1
+	CodeSuccess       int32 = 0
+	CodeInvalidParam  int32 = 1
 	CodeAlreadyExists int32 = 2
-	CodeInternal     int32 = 3
+	CodeInternal      int32 = 3
+	CodeLocked        int32 = 4
+)
+
+// Roles written into access tokens. Users without rows in t_user_role get RoleUser.
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// Account states stored in t_user.status.
+const (
+	StatusDisabled    = 0
+	StatusActive      = 1
+	StatusPending     = 2
+	StatusDeactivated = 3
+)
+
+// Auth types stored in t_user_auth.auth_type.
+const (
+	AuthTypePhone int32 = 1
+	AuthTypeEmail int32 = 2
 )
 
 // Database query timeout for all SQL operations.
 const dbQueryTimeout = 5 * time.Second
+
+// Redis operation timeout for lockout and cache lookups.
+const redisOpTimeout = 2 * time.Second
+
+// Privacy levels stored in t_user_settings.privacy_level.
+const (
+	PrivacyPrivate   int32 = 0
+	PrivacyPublic    int32 = 1
+	PrivacyFollowers int32 = 2
+)
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type DeactivateAccountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeactivateAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeactivateAccountLogic {
+	return &DeactivateAccountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeactivateAccount soft-deletes user_id. The account is purged once the grace period ends.
+func (l *DeactivateAccountLogic) DeactivateAccount(in *userpb.DeactivateAccountRequest) (*userpb.DeactivateAccountResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+
+	// Step 2: Flip an active account to deactivated. Disabled accounts stay disabled, so an
+	// account locked by an admin cannot be unlocked by deactivating and reactivating it.
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var deletedAt sql.NullTime
+	err = l.svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		var status int
+		if err := session.QueryRowCtx(ctx, &status,
+			`SELECT status FROM t_user WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, parsedID); err != nil {
+			return err
+		}
+		if status != StatusActive {
+			return errAccountDisabled
+		}
+		if _, err := session.ExecCtx(ctx,
+			`UPDATE t_user SET status = ?, deleted_at = NOW() WHERE id = ?`, StatusDeactivated, parsedID); err != nil {
+			return err
+		}
+		return session.QueryRowCtx(ctx, &deletedAt, `SELECT deleted_at FROM t_user WHERE id = ?`, parsedID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sqlx.ErrNotFound):
+			return nil, errUserNotFound
+		case errors.Is(err, errAccountDisabled):
+			return nil, errAccountDisabled
+		}
+		l.Errorf("deactivate account: update failed: %v", err)
+		return nil, errInternal
+	}
+	markWritten(l.ctx, l.svcCtx, parsedID)
+
+	purgeAfter := deletedAt.Time.Add(time.Duration(l.svcCtx.Config.Account.GraceSeconds) * time.Second)
+	l.Infof("deactivate account: success, userId=%d purgeAfter=%s", parsedID, purgeAfter.Format(time.RFC3339))
+	return &userpb.DeactivateAccountResponse{
+		PurgeAfter: formatTime(sql.NullTime{Time: purgeAfter, Valid: deletedAt.Valid}),
+	}, nil
+}
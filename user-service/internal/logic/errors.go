@@ -0,0 +1,125 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Error reasons carried in errdetails.ErrorInfo, so callers can branch without parsing messages.
+const (
+	errorDomain = "user-service.astraios"
+
+	ReasonInvalidArgument    = "INVALID_ARGUMENT"
+	ReasonUsernameTaken      = "USERNAME_TAKEN"
+	ReasonInvalidCredentials = "INVALID_CREDENTIALS"
+	ReasonAccountLocked      = "ACCOUNT_LOCKED"
+	ReasonUserNotFound       = "USER_NOT_FOUND"
+	ReasonIdentifierTaken    = "IDENTIFIER_TAKEN"
+	ReasonIdentifierNotFound = "IDENTIFIER_NOT_FOUND"
+	ReasonIdentifierBound    = "IDENTIFIER_ALREADY_BOUND"
+	ReasonCodeRecentlySent   = "CODE_RECENTLY_SENT"
+	ReasonCodeExpired        = "CODE_EXPIRED"
+	ReasonInvalidCode        = "INVALID_CODE"
+	ReasonAccountDisabled    = "ACCOUNT_DISABLED"
+	ReasonOAuthTaken         = "OAUTH_TAKEN"
+	ReasonOAuthNotBound      = "OAUTH_NOT_BOUND"
+	ReasonPlatformBound      = "PLATFORM_ALREADY_BOUND"
+	ReasonGracePeriodExpired = "GRACE_PERIOD_EXPIRED"
+	ReasonResetTokenInvalid  = "RESET_TOKEN_INVALID"
+	ReasonStatusLocked       = "STATUS_NOT_CHANGEABLE"
+	ReasonNoPendingUpload    = "NO_PENDING_UPLOAD"
+	ReasonObjectNotUploaded  = "OBJECT_NOT_UPLOADED"
+	ReasonLastSignInMethod   = "LAST_SIGN_IN_METHOD"
+	ReasonListHidden         = "LIST_HIDDEN"
+	ReasonInternal           = "INTERNAL"
+)
+
+// Callers that send ErrorModeMetadataKey=ErrorModeStatus receive gRPC status errors from
+// Register and VerifyPassword. Everyone else still gets the deprecated in-body Code fields.
+const (
+	ErrorModeMetadataKey = "x-error-mode"
+	ErrorModeStatus      = "status"
+)
+
+var (
+	errUserNotFound       = newStatusError(codes.NotFound, ReasonUserNotFound, "user not found")
+	errUsernameTaken      = newStatusError(codes.AlreadyExists, ReasonUsernameTaken, "username already exists")
+	errInvalidCredentials = newStatusError(codes.Unauthenticated, ReasonInvalidCredentials, "invalid credentials")
+	errInternal           = newStatusError(codes.Internal, ReasonInternal, "internal error")
+	errIdentifierTaken    = newStatusError(codes.AlreadyExists, ReasonIdentifierTaken, "identifier is bound to another account")
+	errIdentifierNotFound = newStatusError(codes.NotFound, ReasonIdentifierNotFound, "identifier not bound")
+	errCodeExpired        = newStatusError(codes.FailedPrecondition, ReasonCodeExpired, "verification code expired or not requested")
+	errAccountDisabled    = newStatusError(codes.PermissionDenied, ReasonAccountDisabled, "account disabled")
+	errOAuthTaken         = newStatusError(codes.AlreadyExists, ReasonOAuthTaken, "third-party account is bound to another user")
+	errOAuthNotBound      = newStatusError(codes.NotFound, ReasonOAuthNotBound, "third-party account not bound")
+	errGracePeriodExpired = newStatusError(codes.FailedPrecondition, ReasonGracePeriodExpired, "account can no longer be reactivated")
+	errResetTokenInvalid  = newStatusError(codes.FailedPrecondition, ReasonResetTokenInvalid, "reset token expired or already used")
+	errStatusLocked       = newStatusError(codes.FailedPrecondition, ReasonStatusLocked, "deactivated accounts cannot be enabled or disabled")
+	errNoPendingUpload    = newStatusError(codes.FailedPrecondition, ReasonNoPendingUpload, "no pending upload for this object key, request a new upload URL")
+	errObjectNotUploaded  = newStatusError(codes.FailedPrecondition, ReasonObjectNotUploaded, "object has not been uploaded yet")
+	errLastSignInMethod   = newStatusError(codes.FailedPrecondition, ReasonLastSignInMethod, "cannot remove the last way to sign in, set a password or bind another account first")
+	errListHidden         = newStatusError(codes.PermissionDenied, ReasonListHidden, "list hidden by user")
+	errInvalidCode        = newStatusError(codes.InvalidArgument, ReasonInvalidCode, "invalid verification code",
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "code", Description: "invalid verification code"},
+		}})
+)
+
+// newStatusError builds a status error with an ErrorInfo detail plus any extra details.
+func newStatusError(code codes.Code, reason, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+	details = append([]protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}}, details...)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// invalidArgument reports a single bad field as InvalidArgument with a BadRequest detail.
+func invalidArgument(field, description string) error {
+	return newStatusError(codes.InvalidArgument, ReasonInvalidArgument, description, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+}
+
+// accountLocked reports a locked username with a RetryInfo hint.
+func accountLocked(retryAfter time.Duration) error {
+	return retryLater(ReasonAccountLocked, "account temporarily locked", retryAfter)
+}
+
+// retryLater reports a throttled operation as ResourceExhausted with a RetryInfo hint.
+func retryLater(reason, msg string, retryAfter time.Duration) error {
+	return newStatusError(codes.ResourceExhausted, reason, msg,
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+}
+
+// usesStatusErrors reports whether the caller opted in to status errors.
+func usesStatusErrors(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, mode := range md.Get(ErrorModeMetadataKey) {
+		if mode == ErrorModeStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// legacyResult returns err to callers that opted in to status errors and the legacy
+// in-body response to everyone else.
+func legacyResult[T any](ctx context.Context, legacy T, err error) (T, error) {
+	if usesStatusErrors(ctx) {
+		var zero T
+		return zero, err
+	}
+	return legacy, nil
+}
@@ -0,0 +1,214 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	defaultFollowPageSize = 20
+	maxFollowPageSize     = 100
+	// followListAvatarSize is the avatar variant shown in follow lists.
+	followListAvatarSize = 128
+)
+
+// parseFollowPair validates the two user ids of a FollowRequest.
+func parseFollowPair(in *userpb.FollowRequest) (int64, int64, error) {
+	if in == nil {
+		return 0, 0, invalidArgument("request", "request is required")
+	}
+	userID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return 0, 0, invalidArgument("user_id", "invalid user_id format")
+	}
+	targetID, err := strconv.ParseInt(strings.TrimSpace(in.TargetUserId), 10, 64)
+	if err != nil {
+		return 0, 0, invalidArgument("target_user_id", "invalid target_user_id format")
+	}
+	if userID == targetID {
+		return 0, 0, invalidArgument("target_user_id", "cannot follow yourself")
+	}
+	return userID, targetID, nil
+}
+
+// followPage is a validated FollowListRequest. cursor is 0 for the first page and viewerID 0
+// for anonymous viewers.
+type followPage struct {
+	userID   int64
+	viewerID int64
+	cursor   int64
+	limit    int
+}
+
+func parseFollowPage(in *userpb.FollowListRequest) (*followPage, error) {
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	userID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	page := &followPage{userID: userID, limit: defaultFollowPageSize}
+	if viewer := strings.TrimSpace(in.ViewerId); viewer != "" {
+		page.viewerID, err = strconv.ParseInt(viewer, 10, 64)
+		if err != nil {
+			return nil, invalidArgument("viewer_id", "invalid viewer_id format")
+		}
+	}
+	if cursor := strings.TrimSpace(in.Cursor); cursor != "" {
+		page.cursor, err = strconv.ParseInt(cursor, 10, 64)
+		if err != nil || page.cursor <= 0 {
+			return nil, invalidArgument("cursor", "invalid cursor")
+		}
+	}
+	if in.Limit < 0 {
+		return nil, invalidArgument("limit", "limit must not be negative")
+	}
+	if in.Limit > 0 {
+		page.limit = min(int(in.Limit), maxFollowPageSize)
+	}
+	return page, nil
+}
+
+// checkFollowListVisible returns errListHidden unless the page's viewer may see the owner's
+// followers, or following when followers is false. Owners always see their own lists; others
+// need a public profile, or to follow a followers-only one, and the matching show_* setting.
+// Users without a settings row get the column defaults; none is created. Lists of disabled and
+// deactivated accounts are reported as not found, as their profiles are.
+func checkFollowListVisible(ctx context.Context, svcCtx *svc.ServiceContext, page *followPage, followers bool) error {
+	if page.viewerID == page.userID {
+		return nil
+	}
+	var settings struct {
+		PrivacyLevel  int32 `db:"privacy_level"`
+		ShowFollowing int   `db:"show_following"`
+		ShowFollowers int   `db:"show_followers"`
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	err := readConnFor(ctx, svcCtx, page.userID).QueryRowCtx(queryCtx, &settings, `
+SELECT COALESCE(st.privacy_level, ?) AS privacy_level,
+       COALESCE(st.show_following, 1) AS show_following, COALESCE(st.show_followers, 1) AS show_followers
+FROM t_user u
+LEFT JOIN t_user_settings st ON st.user_id = u.id
+WHERE u.id = ? AND u.status = ? AND u.deleted_at IS NULL
+LIMIT 1`, PrivacyPublic, page.userID, StatusActive)
+	if err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			return errUserNotFound
+		}
+		return err
+	}
+
+	switch settings.PrivacyLevel {
+	case PrivacyPublic:
+	case PrivacyFollowers:
+		if page.viewerID == 0 {
+			return errListHidden
+		}
+		following, _, err := followRelation(ctx, svcCtx, page.viewerID, page.userID)
+		if err != nil {
+			return err
+		}
+		if !following {
+			return errListHidden
+		}
+	default:
+		return errListHidden
+	}
+	visible := settings.ShowFollowing == 1
+	if followers {
+		visible = settings.ShowFollowers == 1
+	}
+	if !visible {
+		return errListHidden
+	}
+	return nil
+}
+
+type followRow struct {
+	ID        int64          `db:"id"`
+	UserID    int64          `db:"user_id"`
+	Nickname  sql.NullString `db:"nickname"`
+	Avatar    sql.NullString `db:"avatar"`
+	CreatedAt sql.NullTime   `db:"created_at"`
+}
+
+// listFollows pages through t_user_follow. ownColumn is the column matching page.userID and
+// otherColumn the one holding the listed users. Deleted and inactive users are skipped. Avatars
+// are presigned; one that cannot be degrades to an empty URL instead of failing the page.
+func listFollows(ctx context.Context, svcCtx *svc.ServiceContext, page *followPage, ownColumn, otherColumn string) (*userpb.FollowListResponse, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+
+	query := `SELECT f.id, f.` + otherColumn + ` AS user_id, p.nickname, p.avatar, f.created_at
+		FROM t_user_follow f
+		JOIN t_user u ON u.id = f.` + otherColumn + ` AND u.status = ? AND u.deleted_at IS NULL
+		LEFT JOIN t_user_profile p ON p.user_id = f.` + otherColumn + `
+		WHERE f.` + ownColumn + ` = ?`
+	args := []any{StatusActive, page.userID}
+	if page.cursor > 0 {
+		query += ` AND f.id < ?`
+		args = append(args, page.cursor)
+	}
+	// Fetch one extra row to know whether another page exists.
+	query += ` ORDER BY f.id DESC LIMIT ?`
+	args = append(args, page.limit+1)
+
+	var rows []followRow
+	if err := readConnFor(ctx, svcCtx, page.userID).QueryRowsCtx(queryCtx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	resp := &userpb.FollowListResponse{}
+	if len(rows) > page.limit {
+		rows = rows[:page.limit]
+		resp.NextCursor = strconv.FormatInt(rows[len(rows)-1].ID, 10)
+	}
+	resp.Users = make([]*userpb.FollowUser, 0, len(rows))
+	for _, row := range rows {
+		avatarURL, err := resolveImageURL(ctx, svcCtx, avatarForSize(nullString(row.Avatar), followListAvatarSize))
+		if err != nil {
+			logx.WithContext(ctx).Errorf("list follows: resolve avatar failed, userId=%d: %v", row.UserID, err)
+			avatarURL = ""
+		}
+		resp.Users = append(resp.Users, &userpb.FollowUser{
+			UserId:     strconv.FormatInt(row.UserID, 10),
+			Nickname:   nullString(row.Nickname),
+			Avatar:     avatarURL,
+			FollowedAt: formatTime(row.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// followRelation reports whether userID follows targetID and whether targetID follows userID.
+func followRelation(ctx context.Context, svcCtx *svc.ServiceContext, userID, targetID int64) (bool, bool, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	var followers []int64
+	if err := readConnFor(ctx, svcCtx, userID).QueryRowsCtx(queryCtx, &followers,
+		`SELECT follower_id FROM t_user_follow
+		WHERE (follower_id = ? AND followee_id = ?) OR (follower_id = ? AND followee_id = ?)`,
+		userID, targetID, targetID, userID); err != nil {
+		return false, false, err
+	}
+	var following, followedBy bool
+	for _, follower := range followers {
+		if follower == userID {
+			following = true
+		} else {
+			followedBy = true
+		}
+	}
+	return following, followedBy, nil
+}
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type FollowLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFollowLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FollowLogic {
+	return &FollowLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Follow makes user_id follow target_user_id. Following twice is a no-op.
+func (l *FollowLogic) Follow(in *userpb.FollowRequest) (*userpb.FollowResponse, error) {
+	// Step 1: Validate request parameters.
+	userID, targetID, err := parseFollowPair(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Insert the relationship and bump both counters in one transaction.
+	// A duplicate insert affects no rows, which keeps repeated calls from double counting.
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	err = l.svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		var exists int64
+		if err := session.QueryRowCtx(ctx, &exists,
+			`SELECT COUNT(1) FROM t_user WHERE id = ? AND status = ? AND deleted_at IS NULL`,
+			targetID, StatusActive); err != nil {
+			return err
+		}
+		if exists == 0 {
+			return errUserNotFound
+		}
+		result, err := session.ExecCtx(ctx,
+			`INSERT IGNORE INTO t_user_follow (follower_id, followee_id) VALUES (?, ?)`, userID, targetID)
+		if err != nil {
+			return err
+		}
+		if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+			return err
+		}
+		if _, err := session.ExecCtx(ctx,
+			`INSERT INTO t_user_stats (user_id, following_count) VALUES (?, 1)
+			ON DUPLICATE KEY UPDATE following_count = following_count + 1`, userID); err != nil {
+			return err
+		}
+		_, err = session.ExecCtx(ctx,
+			`INSERT INTO t_user_stats (user_id, follower_count) VALUES (?, 1)
+			ON DUPLICATE KEY UPDATE follower_count = follower_count + 1`, targetID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, errUserNotFound
+		}
+		l.Errorf("follow: insert failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Both users' lists and counters changed.
+	markWritten(l.ctx, l.svcCtx, userID, targetID)
+	l.Infof("follow: success, userId=%d targetUserId=%d", userID, targetID)
+	return &userpb.FollowResponse{Following: true}, nil
+}
@@ -0,0 +1,142 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type GetPublicProfileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPublicProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPublicProfileLogic {
+	return &GetPublicProfileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetPublicProfile returns what viewer_id may see of user_id's profile under their privacy_level.
+func (l *GetPublicProfileLogic) GetPublicProfile(in *userpb.PublicProfileRequest) (*userpb.PublicProfileResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	userID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	var viewerID int64
+	if viewer := strings.TrimSpace(in.ViewerId); viewer != "" {
+		viewerID, err = strconv.ParseInt(viewer, 10, 64)
+		if err != nil {
+			return nil, invalidArgument("viewer_id", "invalid viewer_id format")
+		}
+	}
+
+	// Step 2: Load profile, stats and privacy level in one query. Disabled and deactivated
+	// accounts are reported as not found.
+	var record struct {
+		UserID          int64          `db:"id"`
+		CreatedAt       sql.NullTime   `db:"created_at"`
+		Nickname        sql.NullString `db:"nickname"`
+		Avatar          sql.NullString `db:"avatar"`
+		Gender          sql.NullInt64  `db:"gender"`
+		Bio             sql.NullString `db:"bio"`
+		BackgroundImage sql.NullString `db:"background_image"`
+		Country         sql.NullString `db:"country"`
+		Province        sql.NullString `db:"province"`
+		City            sql.NullString `db:"city"`
+		School          sql.NullString `db:"school"`
+		Major           sql.NullString `db:"major"`
+		FollowingCount  int64          `db:"following_count"`
+		FollowerCount   int64          `db:"follower_count"`
+		LikesCount      int64          `db:"likes_count"`
+		VideoCount      int64          `db:"video_count"`
+		PrivacyLevel    int32          `db:"privacy_level"`
+	}
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	err = readConnFor(l.ctx, l.svcCtx, userID).QueryRowCtx(queryCtx, &record, `
+SELECT u.id, u.created_at, p.nickname, p.avatar, p.gender, p.bio, p.background_image,
+       p.country, p.province, p.city, p.school, p.major,
+       COALESCE(s.following_count, 0) AS following_count, COALESCE(s.follower_count, 0) AS follower_count,
+       COALESCE(s.likes_count, 0) AS likes_count, COALESCE(s.video_count, 0) AS video_count,
+       COALESCE(st.privacy_level, ?) AS privacy_level
+FROM t_user u
+LEFT JOIN t_user_profile p ON p.user_id = u.id
+LEFT JOIN t_user_stats s ON s.user_id = u.id
+LEFT JOIN t_user_settings st ON st.user_id = u.id
+WHERE u.id = ? AND u.status = ? AND u.deleted_at IS NULL
+LIMIT 1`, PrivacyPublic, userID, StatusActive)
+	if err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			return nil, errUserNotFound
+		}
+		l.Errorf("get public profile: query failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 3: Work out the relationship and whether the viewer may see the full profile.
+	resp := &userpb.PublicProfileResponse{
+		UserId:   strconv.FormatInt(record.UserID, 10),
+		Nickname: nullString(record.Nickname),
+	}
+	if viewerID != 0 && viewerID != userID {
+		resp.Following, resp.FollowedBy, err = followRelation(l.ctx, l.svcCtx, viewerID, userID)
+		if err != nil {
+			l.Errorf("get public profile: query relationship failed: %v", err)
+			return nil, errInternal
+		}
+	}
+	switch {
+	case viewerID == userID:
+	case record.PrivacyLevel == PrivacyPublic:
+	case record.PrivacyLevel == PrivacyFollowers && resp.Following:
+	default:
+		resp.Restricted = true
+	}
+
+	// Step 4: Fill the projection. Image failures degrade to an empty URL instead of failing the view.
+	resp.AvatarUrl = l.imageURL(record.Avatar.String)
+	if resp.Restricted {
+		return resp, nil
+	}
+	resp.Gender = nullInt32(record.Gender)
+	resp.Bio = nullString(record.Bio)
+	resp.BackgroundImageUrl = l.imageURL(record.BackgroundImage.String)
+	resp.Country = nullString(record.Country)
+	resp.Province = nullString(record.Province)
+	resp.City = nullString(record.City)
+	resp.School = nullString(record.School)
+	resp.Major = nullString(record.Major)
+	resp.CreatedAt = formatTime(record.CreatedAt)
+	resp.Stats = &userpb.UserStats{
+		FollowingCount: record.FollowingCount,
+		FollowerCount:  record.FollowerCount,
+		LikesCount:     record.LikesCount,
+		VideoCount:     record.VideoCount,
+	}
+	return resp, nil
+}
+
+func (l *GetPublicProfileLogic) imageURL(value string) string {
+	url, err := resolveImageURL(l.ctx, l.svcCtx, value)
+	if err != nil {
+		l.Errorf("get public profile: resolve image failed: %v", err)
+		return ""
+	}
+	return url
+}
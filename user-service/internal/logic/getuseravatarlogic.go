@@ -2,19 +2,15 @@ package logic
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"strconv"
 	"strings"
 
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
-	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
 	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GetUserAvatarLogic struct {
@@ -33,60 +29,36 @@ func NewGetUserAvatarLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Get
 
 func (l *GetUserAvatarLogic) GetUserAvatar(in *userpb.UserAvatarRequest) (*userpb.UserAvatarResponse, error) {
 	if in == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
+		return nil, invalidArgument("request", "request is required")
 	}
 	userID := strings.TrimSpace(in.UserId)
 	if userID == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, invalidArgument("user_id", "user_id is required")
 	}
 	parsedID, err := strconv.ParseInt(userID, 10, 64)
 	if err != nil {
 		l.Infof("get user avatar: invalid user_id format: %s", userID)
-		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+		return nil, invalidArgument("user_id", "invalid user_id format")
 	}
-
-	var record struct {
-		Avatar sql.NullString `db:"avatar"`
+	if in.AvatarSize < 0 {
+		return nil, invalidArgument("avatar_size", "avatar_size must not be negative")
 	}
-	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
-	defer cancel()
-	err = l.svcCtx.ReadConn.QueryRowCtx(queryCtx, &record, `
-SELECT avatar
-FROM t_user_profile
-WHERE user_id = ?
-LIMIT 1`, parsedID)
+
+	record, err := loadProfile(l.ctx, l.svcCtx, parsedID)
 	if err != nil {
 		if errors.Is(err, sqlx.ErrNotFound) {
 			l.Infof("get user avatar: not found, userId=%s", userID)
-			return nil, status.Error(codes.NotFound, "user not found")
+			return nil, errUserNotFound
 		}
 		l.Errorf("get user avatar: query failed: %v", err)
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, errInternal
 	}
 
-	avatarValue := strings.TrimSpace(record.Avatar.String)
-	if !record.Avatar.Valid || avatarValue == "" {
-		return &userpb.UserAvatarResponse{AvatarUrl: ""}, nil
-	}
-	if isHTTPURL(avatarValue) {
-		return &userpb.UserAvatarResponse{AvatarUrl: avatarValue}, nil
-	}
-	if err := util.ValidateObjectName(avatarValue); err != nil {
-		l.Errorf("get user avatar: invalid avatar object key: %v", err)
-		return nil, status.Error(codes.Internal, "invalid avatar object key")
-	}
-
-	if l.svcCtx.OSSClient == nil {
-		l.Errorf("get user avatar: oss client not initialized")
-		return nil, status.Error(codes.Internal, "internal error")
-	}
-	ossCtx, cancel := context.WithTimeout(l.ctx, ossOpTimeout)
-	defer cancel()
-	presign, err := l.svcCtx.OSSClient.PresignGet(ossCtx, avatarValue, avatarDisplayExpiry)
+	avatarURL, err := resolveImageURL(l.ctx, l.svcCtx, avatarForSize(record.Avatar.String, in.AvatarSize))
 	if err != nil {
-		l.Errorf("get user avatar: presign get failed: %v", err)
-		return nil, status.Error(codes.Internal, "internal error")
+		l.Errorf("get user avatar: resolve avatar failed: %v", err)
+		return nil, errInternal
 	}
 
-	return &userpb.UserAvatarResponse{AvatarUrl: presign.URL}, nil
+	return &userpb.UserAvatarResponse{AvatarUrl: avatarURL}, nil
 }
@@ -13,8 +13,6 @@ import (
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type GetUserDataLogic struct {
@@ -33,71 +31,45 @@ func NewGetUserDataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUs
 
 func (l *GetUserDataLogic) GetUserData(in *userpb.UserDataRequest) (*userpb.UserDataResponse, error) {
 	if in == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
+		return nil, invalidArgument("request", "request is required")
 	}
 	userID := strings.TrimSpace(in.UserId)
 	if userID == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, invalidArgument("user_id", "user_id is required")
 	}
 
 	parsedID, err := strconv.ParseInt(userID, 10, 64)
 	if err != nil {
 		l.Infof("get user data: invalid user_id format: %s", userID)
-		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+		return nil, invalidArgument("user_id", "invalid user_id format")
 	}
 
-	var record struct {
-		UserID          int64          `db:"user_id"`
-		Nickname        sql.NullString `db:"nickname"`
-		Avatar          sql.NullString `db:"avatar"`
-		Gender          sql.NullInt64  `db:"gender"`
-		Birthday        sql.NullTime   `db:"birthday"`
-		Bio             sql.NullString `db:"bio"`
-		BackgroundImage sql.NullString `db:"background_image"`
-		Country         sql.NullString `db:"country"`
-		Province        sql.NullString `db:"province"`
-		City            sql.NullString `db:"city"`
-		School          sql.NullString `db:"school"`
-		Major           sql.NullString `db:"major"`
-		GraduationYear  sql.NullInt64  `db:"graduation_year"`
-		CreatedAt       sql.NullTime   `db:"created_at"`
-		UpdatedAt       sql.NullTime   `db:"updated_at"`
-	}
-
-	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
-	defer cancel()
-	err = l.svcCtx.ReadConn.QueryRowCtx(queryCtx, &record, `
-SELECT user_id, nickname, avatar, gender, birthday, bio, background_image, country, province, city,
-       school, major, graduation_year, created_at, updated_at
-FROM t_user_profile
-WHERE user_id = ?
-LIMIT 1`, parsedID)
+	record, err := loadProfile(l.ctx, l.svcCtx, parsedID)
 	if err != nil {
 		if errors.Is(err, sqlx.ErrNotFound) {
 			l.Infof("get user data: not found, userId=%s", userID)
-			return nil, status.Error(codes.NotFound, "user not found")
+			return nil, errUserNotFound
 		}
 		l.Errorf("get user data: query failed: %v", err)
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, errInternal
 	}
 
-	return &userpb.UserDataResponse{
-		UserId:          strconv.FormatInt(record.UserID, 10),
-		Nickname:        nullString(record.Nickname),
-		Avatar:          nullString(record.Avatar),
-		Gender:          nullInt32(record.Gender),
-		Birthday:        formatDate(record.Birthday),
-		Bio:             nullString(record.Bio),
-		BackgroundImage: nullString(record.BackgroundImage),
-		Country:         nullString(record.Country),
-		Province:        nullString(record.Province),
-		City:            nullString(record.City),
-		School:          nullString(record.School),
-		Major:           nullString(record.Major),
-		GraduationYear:  nullInt32(record.GraduationYear),
-		CreatedAt:       formatTime(record.CreatedAt),
-		UpdatedAt:       formatTime(record.UpdatedAt),
-	}, nil
+	// Stored values stay as they are; the URLs are for display and expire.
+	resp := record.toProto()
+	resp.AvatarUrl = l.imageURL(resp.Avatar)
+	resp.BackgroundImageUrl = l.imageURL(resp.BackgroundImage)
+	return resp, nil
+}
+
+// imageURL resolves a stored image for display. A failure is logged and leaves the URL empty
+// rather than failing the whole profile.
+func (l *GetUserDataLogic) imageURL(value string) string {
+	url, err := resolveImageURL(l.ctx, l.svcCtx, value)
+	if err != nil {
+		l.Errorf("get user data: resolve image failed: %v", err)
+		return ""
+	}
+	return url
 }
 
 func nullString(value sql.NullString) string {
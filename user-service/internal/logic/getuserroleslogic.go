@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type GetUserRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserRolesLogic {
+	return &GetUserRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetUserRoles returns the current username and roles of an active account, so tokens are
+// re-issued with what is stored now rather than what an older token claimed.
+func (l *GetUserRolesLogic) GetUserRoles(in *userpb.UserRolesRequest) (*userpb.UserRolesResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+
+	// Step 2: Load the account from the primary, so a disable or role change made just before a
+	// refresh already applies.
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var account struct {
+		Username string `db:"username"`
+		Status   int    `db:"status"`
+	}
+	if err := l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &account,
+		`SELECT username, status FROM t_user WHERE id = ? AND deleted_at IS NULL LIMIT 1`, parsedID); err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			return nil, errUserNotFound
+		}
+		l.Errorf("get user roles: query user failed: %v", err)
+		return nil, errInternal
+	}
+	if account.Status != StatusActive {
+		l.Infof("get user roles: account not active, userId=%d status=%d", parsedID, account.Status)
+		return nil, errAccountDisabled
+	}
+
+	// Step 3: Load the roles, from the primary as well.
+	var roles []string
+	if err := l.svcCtx.WriteConn.QueryRowsCtx(queryCtx, &roles,
+		`SELECT role FROM t_user_role WHERE user_id = ? ORDER BY role`, parsedID); err != nil {
+		l.Errorf("get user roles: query roles failed: %v", err)
+		return nil, errInternal
+	}
+	if len(roles) == 0 {
+		roles = []string{RoleUser}
+	}
+
+	return &userpb.UserRolesResponse{
+		UserId:   strconv.FormatInt(parsedID, 10),
+		Username: account.Username,
+		Roles:    roles,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type GetUserSettingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserSettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserSettingsLogic {
+	return &GetUserSettingsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetUserSettings returns the user's settings, creating the row with defaults on first access.
+func (l *GetUserSettingsLogic) GetUserSettings(in *userpb.UserSettingsRequest) (*userpb.UserSettingsResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+
+	// Step 2: Read the settings row.
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var record settingsRecord
+	err = readConnFor(l.ctx, l.svcCtx, parsedID).QueryRowCtx(queryCtx, &record,
+		`SELECT `+settingsColumns+` FROM t_user_settings WHERE user_id = ? LIMIT 1`, parsedID)
+	if err == nil {
+		return &userpb.UserSettingsResponse{Settings: record.toProto()}, nil
+	}
+	if !errors.Is(err, sqlx.ErrNotFound) {
+		l.Errorf("get user settings: query failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 3: First access, create the row with defaults and read it back from the primary.
+	err = l.svcCtx.WriteConn.TransactCtx(queryCtx, func(ctx context.Context, session sqlx.Session) error {
+		if err := ensureSettingsRow(ctx, session, parsedID); err != nil {
+			return err
+		}
+		return session.QueryRowCtx(ctx, &record,
+			`SELECT `+settingsColumns+` FROM t_user_settings WHERE user_id = ? LIMIT 1`, parsedID)
+	})
+	if err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, errUserNotFound
+		}
+		l.Errorf("get user settings: create defaults failed: %v", err)
+		return nil, errInternal
+	}
+
+	markWritten(l.ctx, l.svcCtx, parsedID)
+	l.Infof("get user settings: created defaults, userId=%d", parsedID)
+	return &userpb.UserSettingsResponse{Settings: record.toProto()}, nil
+}
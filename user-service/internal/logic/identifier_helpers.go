@@ -0,0 +1,166 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+)
+
+const (
+	verifyCodePrefix     = "user:verify:code:"
+	verifyAttemptsPrefix = "user:verify:attempts:"
+	verifyCooldownPrefix = "user:verify:cooldown:"
+	verifyCodeDigits     = 6
+	maxIdentifierLength  = 100
+)
+
+var (
+	phoneRegex = regexp.MustCompile(`^\+?[0-9]{6,15}$`)
+	emailRegex = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+)
+
+// normalizeIdentifier validates a phone number or email for authType and returns its stored form.
+func normalizeIdentifier(authType int32, identifier string) (string, error) {
+	identifier = strings.TrimSpace(identifier)
+	switch authType {
+	case AuthTypePhone:
+		phone := strings.NewReplacer(" ", "", "-", "").Replace(identifier)
+		if !phoneRegex.MatchString(phone) {
+			return "", fmt.Errorf("invalid phone number")
+		}
+		return phone, nil
+	case AuthTypeEmail:
+		email := strings.ToLower(identifier)
+		if len(email) > maxIdentifierLength || !emailRegex.MatchString(email) {
+			return "", fmt.Errorf("invalid email address")
+		}
+		return email, nil
+	default:
+		return "", fmt.Errorf("unsupported auth_type %d", authType)
+	}
+}
+
+// detectLoginIdentifier reports whether a login name looks like an email or phone number.
+func detectLoginIdentifier(login string) (int32, string, bool) {
+	authType := AuthTypePhone
+	if strings.Contains(login, "@") {
+		authType = AuthTypeEmail
+	}
+	identifier, err := normalizeIdentifier(authType, login)
+	if err != nil {
+		return 0, "", false
+	}
+	return authType, identifier, true
+}
+
+func authChannel(authType int32) string {
+	if authType == AuthTypeEmail {
+		return "email"
+	}
+	return "phone"
+}
+
+func identifierKey(prefix string, authType int32, identifier string) string {
+	return prefix + strconv.Itoa(int(authType)) + ":" + identifier
+}
+
+// newVerifyCode returns a random numeric code of verifyCodeDigits digits.
+func newVerifyCode() (string, error) {
+	limit := big.NewInt(1)
+	for i := 0; i < verifyCodeDigits; i++ {
+		limit.Mul(limit, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", verifyCodeDigits, n), nil
+}
+
+// reserveCodeSend enforces the resend gap. It returns the remaining wait when a code was sent recently.
+func reserveCodeSend(ctx context.Context, svcCtx *svc.ServiceContext, authType int32, identifier string) (time.Duration, error) {
+	seconds := svcCtx.Config.Verification.ResendSeconds
+	if seconds <= 0 {
+		return 0, nil
+	}
+	key := identifierKey(verifyCooldownPrefix, authType, identifier)
+
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	ok, err := svcCtx.Redis.SetnxExCtx(ctx, key, "1", seconds)
+	if err != nil || ok {
+		return 0, err
+	}
+	ttl, err := svcCtx.Redis.TtlCtx(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if ttl <= 0 {
+		ttl = 1
+	}
+	return time.Duration(ttl) * time.Second, nil
+}
+
+// releaseCodeSend drops a reservation whose code was never sent, so the user can retry at once.
+func releaseCodeSend(ctx context.Context, svcCtx *svc.ServiceContext, authType int32, identifier string) error {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	_, err := svcCtx.Redis.DelCtx(ctx, identifierKey(verifyCooldownPrefix, authType, identifier))
+	return err
+}
+
+// storeVerifyCode saves the code for userID and resets the attempt counter.
+func storeVerifyCode(ctx context.Context, svcCtx *svc.ServiceContext, authType int32, identifier, userID, code string) error {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	if _, err := svcCtx.Redis.DelCtx(ctx, identifierKey(verifyAttemptsPrefix, authType, identifier)); err != nil {
+		return err
+	}
+	return svcCtx.Redis.SetexCtx(ctx, identifierKey(verifyCodePrefix, authType, identifier),
+		userID+":"+code, svcCtx.Config.Verification.CodeTTLSeconds)
+}
+
+// checkVerifyCode compares code with the one stored for userID.
+// It returns an error describing why verification failed, or nil on a match.
+func checkVerifyCode(ctx context.Context, svcCtx *svc.ServiceContext, authType int32, identifier, userID, code string) error {
+	codeKey := identifierKey(verifyCodePrefix, authType, identifier)
+	attemptsKey := identifierKey(verifyAttemptsPrefix, authType, identifier)
+
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	stored, err := svcCtx.Redis.GetCtx(ctx, codeKey)
+	if err != nil {
+		return errInternal
+	}
+	owner, expected, found := strings.Cut(stored, ":")
+	if !found || owner != userID {
+		return errCodeExpired
+	}
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+		if _, err := svcCtx.Redis.DelCtx(ctx, codeKey); err != nil {
+			return errInternal
+		}
+		_, _ = svcCtx.Redis.DelCtx(ctx, attemptsKey)
+		return nil
+	}
+
+	attempts, err := svcCtx.Redis.IncrCtx(ctx, attemptsKey)
+	if err != nil {
+		return errInternal
+	}
+	_ = svcCtx.Redis.ExpireCtx(ctx, attemptsKey, svcCtx.Config.Verification.CodeTTLSeconds)
+	if attempts >= int64(svcCtx.Config.Verification.MaxAttempts) {
+		// Too many wrong guesses: burn the code so a new one has to be requested.
+		_, _ = svcCtx.Redis.DelCtx(ctx, codeKey)
+		return errCodeExpired
+	}
+	return errInvalidCode
+}
@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type IsFollowingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewIsFollowingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *IsFollowingLogic {
+	return &IsFollowingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// IsFollowing reports the relationship between user_id and target_user_id in both directions.
+func (l *IsFollowingLogic) IsFollowing(in *userpb.FollowRequest) (*userpb.FollowResponse, error) {
+	// Step 1: Validate request parameters.
+	userID, targetID, err := parseFollowPair(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Look up both directions at once.
+	following, followedBy, err := followRelation(l.ctx, l.svcCtx, userID, targetID)
+	if err != nil {
+		l.Errorf("is following: query failed: %v", err)
+		return nil, errInternal
+	}
+	return &userpb.FollowResponse{Following: following, FollowedBy: followedBy}, nil
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFollowersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFollowersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFollowersLogic {
+	return &ListFollowersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListFollowers lists who follows user_id, newest first.
+func (l *ListFollowersLogic) ListFollowers(in *userpb.FollowListRequest) (*userpb.FollowListResponse, error) {
+	// Step 1: Validate request parameters.
+	page, err := parseFollowPage(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Respect the owner's privacy level and list visibility.
+	if err := checkFollowListVisible(l.ctx, l.svcCtx, page, true); err != nil {
+		if errors.Is(err, errListHidden) || errors.Is(err, errUserNotFound) {
+			return nil, err
+		}
+		l.Errorf("list followers: check visibility failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 3: Load one page, newest first.
+	resp, err := listFollows(l.ctx, l.svcCtx, page, "followee_id", "follower_id")
+	if err != nil {
+		l.Errorf("list followers: query failed: %v", err)
+		return nil, errInternal
+	}
+	return resp, nil
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFollowingLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFollowingLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFollowingLogic {
+	return &ListFollowingLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListFollowing lists who user_id follows, newest first.
+func (l *ListFollowingLogic) ListFollowing(in *userpb.FollowListRequest) (*userpb.FollowListResponse, error) {
+	// Step 1: Validate request parameters.
+	page, err := parseFollowPage(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Respect the owner's privacy level and list visibility.
+	if err := checkFollowListVisible(l.ctx, l.svcCtx, page, false); err != nil {
+		if errors.Is(err, errListHidden) || errors.Is(err, errUserNotFound) {
+			return nil, err
+		}
+		l.Errorf("list following: check visibility failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 3: Load one page, newest first.
+	resp, err := listFollows(l.ctx, l.svcCtx, page, "follower_id", "followee_id")
+	if err != nil {
+		l.Errorf("list following: query failed: %v", err)
+		return nil, errInternal
+	}
+	return resp, nil
+}
@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOAuthBindingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOAuthBindingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOAuthBindingsLogic {
+	return &ListOAuthBindingsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListOAuthBindings lists the third-party platforms bound to a user.
+func (l *ListOAuthBindingsLogic) ListOAuthBindings(in *userpb.OAuthRequest) (*userpb.OAuthBindingsResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+
+	// Step 2: Load the bindings.
+	resp, err := listOAuthBindings(l.ctx, readConnFor(l.ctx, l.svcCtx, parsedID), parsedID)
+	if err != nil {
+		l.Errorf("list oauth bindings: query failed: %v", err)
+		return nil, errInternal
+	}
+	return resp, nil
+}
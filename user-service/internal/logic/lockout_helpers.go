@@ -0,0 +1,105 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+)
+
+const (
+	loginFailPrefix = "user:login:fail:"
+	loginLockPrefix = "user:login:lock:"
+)
+
+func loginFailKey(subject string) string {
+	return loginFailPrefix + subject
+}
+
+func loginLockKey(subject string) string {
+	return loginLockPrefix + subject
+}
+
+// accountLockSubject counts failures against an account, so its username, email and phone
+// share one counter.
+func accountLockSubject(userID int64) string {
+	return "id:" + strconv.FormatInt(userID, 10)
+}
+
+// loginLockSubject counts failures against a login that resolves to no account, in its
+// normalized form so reformatting a phone number or email does not start a new counter.
+func loginLockSubject(login string) string {
+	if authType, identifier, ok := detectLoginIdentifier(login); ok {
+		return identifierKey("auth:", authType, identifier)
+	}
+	return "name:" + strings.ToLower(login)
+}
+
+// lockRemaining returns how long subject stays locked, or 0 when it is not locked.
+func lockRemaining(ctx context.Context, svcCtx *svc.ServiceContext, subject string) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	ttl, err := svcCtx.Redis.TtlCtx(ctx, loginLockKey(subject))
+	if err != nil {
+		return 0, err
+	}
+	if ttl <= 0 {
+		return 0, nil
+	}
+	return time.Duration(ttl) * time.Second, nil
+}
+
+// recordLoginFailure counts a failed attempt and locks subject once the threshold is reached.
+// It returns the lock duration applied, or 0 when the subject is not locked yet.
+func recordLoginFailure(ctx context.Context, svcCtx *svc.ServiceContext, subject string) (time.Duration, error) {
+	cfg := svcCtx.Config.Lockout
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+
+	failures, err := svcCtx.Redis.IncrCtx(ctx, loginFailKey(subject))
+	if err != nil {
+		return 0, err
+	}
+	if cfg.MaxAttempts <= 0 || failures < int64(cfg.MaxAttempts) {
+		return 0, svcCtx.Redis.ExpireCtx(ctx, loginFailKey(subject), cfg.WindowSeconds)
+	}
+
+	lockSeconds := lockDuration(cfg.BaseSeconds, cfg.MaxSeconds, failures-int64(cfg.MaxAttempts))
+	if err := svcCtx.Redis.SetexCtx(ctx, loginLockKey(subject), "1", lockSeconds); err != nil {
+		return 0, err
+	}
+	// Keep the counter past the lock so the next failure escalates instead of starting over.
+	if err := svcCtx.Redis.ExpireCtx(ctx, loginFailKey(subject), lockSeconds+cfg.WindowSeconds); err != nil {
+		return 0, err
+	}
+	return time.Duration(lockSeconds) * time.Second, nil
+}
+
+// clearLoginFailures resets the failure counter after a successful password check.
+func clearLoginFailures(ctx context.Context, svcCtx *svc.ServiceContext, subject string) error {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	// Deleted one by one: in cluster mode the two keys may live in different slots.
+	if _, err := svcCtx.Redis.DelCtx(ctx, loginFailKey(subject)); err != nil {
+		return err
+	}
+	_, err := svcCtx.Redis.DelCtx(ctx, loginLockKey(subject))
+	return err
+}
+
+// lockDuration doubles base for every failure past the threshold, capped at maxSeconds.
+func lockDuration(base, maxSeconds int, excess int64) int {
+	if base < 1 {
+		base = 1
+	}
+	seconds := base
+	for i := int64(0); i < excess && seconds < maxSeconds; i++ {
+		seconds *= 2
+	}
+	if maxSeconds > 0 && seconds > maxSeconds {
+		seconds = maxSeconds
+	}
+	return seconds
+}
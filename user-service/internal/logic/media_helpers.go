@@ -0,0 +1,183 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/config"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
+)
+
+const (
+	mediaUploadExpiry  = 60 * time.Minute
+	mediaDisplayExpiry = 30 * time.Minute
+	storeOpTimeout     = 5 * time.Second
+	// mediaPendingExpiry leaves time to confirm an upload that started just before the URL expired.
+	mediaPendingExpiry = mediaUploadExpiry + 10*time.Minute
+)
+
+// mediaSlot is a profile image users upload through SetUserMedia and ConfirmUserMedia. Each
+// slot keeps its objects under {prefix}/{userId}/ and its value in one t_user_profile column.
+type mediaSlot struct {
+	name   string
+	column string
+	prefix string
+	// pendingPrefix is the redis key prefix of the upload awaiting confirmation.
+	pendingPrefix string
+	// confirmedPrefix is the redis key prefix of the latest confirmed upload, kept until it is
+	// processed.
+	confirmedPrefix string
+	limits          func(c config.Config) config.MediaLimits
+	// render turns a confirmed upload into the objects served in its place, keyed by their name
+	// in the upload's variant directory.
+	render func(data []byte, limits config.MediaLimits) (map[string][]byte, error)
+	// served names the rendered object the profile points at.
+	served string
+	// variantPattern matches a processed value and captures its variant directory.
+	variantPattern *regexp.Regexp
+}
+
+var (
+	slotAvatar = &mediaSlot{
+		name:            "avatar",
+		column:          "avatar",
+		prefix:          "avatars",
+		pendingPrefix:   "user:avatar:pending:",
+		confirmedPrefix: "user:avatar:confirmed:",
+		limits:          func(c config.Config) config.MediaLimits { return c.Avatar.Limits() },
+		render:          renderAvatar,
+		served:          avatarVariantName(avatarVariantSizes[len(avatarVariantSizes)-1]),
+		variantPattern:  avatarVariantPattern,
+	}
+	slotBackground = &mediaSlot{
+		name:            "background",
+		column:          "background_image",
+		prefix:          "backgrounds",
+		pendingPrefix:   "user:background:pending:",
+		confirmedPrefix: "user:background:confirmed:",
+		limits:          func(c config.Config) config.MediaLimits { return c.Background.Limits() },
+		render:          renderBackground,
+		served:          backgroundVariantName,
+		variantPattern:  backgroundVariantPattern,
+	}
+
+	mediaSlots = []*mediaSlot{slotAvatar, slotBackground}
+)
+
+// mediaSlotByName resolves a request's slot; empty means the avatar.
+func mediaSlotByName(name string) (*mediaSlot, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return slotAvatar, nil
+	}
+	for _, slot := range mediaSlots {
+		if slot.name == name {
+			return slot, nil
+		}
+	}
+	return nil, invalidArgument("slot", fmt.Sprintf("unknown slot %q", name))
+}
+
+func (s *mediaSlot) confirmedKey(userID int64) string {
+	return s.confirmedPrefix + strconv.FormatInt(userID, 10)
+}
+
+// userPrefix is the directory holding every object of userID in this slot.
+func (s *mediaSlot) userPrefix(userID string) string {
+	return s.prefix + "/" + userID + "/"
+}
+
+// newObjectKey returns a fresh key under the user's prefix ending in extension, so the stored
+// object names the type it was uploaded as.
+func (s *mediaSlot) newObjectKey(userID, extension string) (string, error) {
+	timestamp := time.Now().UTC().Format("20060102T150405Z")
+	suffix, err := randomHex(8)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s-%s%s", s.userPrefix(userID), timestamp, suffix, extension), nil
+}
+
+// savePending records objectKey as the upload userID may confirm next in this slot, replacing
+// any earlier one.
+func (s *mediaSlot) savePending(ctx context.Context, svcCtx *svc.ServiceContext, userID, objectKey string) error {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	return svcCtx.Redis.SetexCtx(ctx, s.pendingPrefix+userID, objectKey, int(mediaPendingExpiry/time.Second))
+}
+
+// pending returns the upload userID may confirm in this slot, or "" when there is none.
+func (s *mediaSlot) pending(ctx context.Context, svcCtx *svc.ServiceContext, userID string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	return svcCtx.Redis.GetCtx(ctx, s.pendingPrefix+userID)
+}
+
+func (s *mediaSlot) clearPending(ctx context.Context, svcCtx *svc.ServiceContext, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	_, err := svcCtx.Redis.DelCtx(ctx, s.pendingPrefix+userID)
+	return err
+}
+
+// deleteMediaObjects removes a replaced image: every variant of a processed one, or the upload
+// itself otherwise.
+func deleteMediaObjects(ctx context.Context, svcCtx *svc.ServiceContext, value string) error {
+	ctx, cancel := context.WithTimeout(ctx, storeOpTimeout)
+	defer cancel()
+	for _, slot := range mediaSlots {
+		if match := slot.variantPattern.FindStringSubmatch(value); match != nil {
+			_, err := util.DeletePrefix(ctx, svcCtx.ObjectStore, match[1])
+			return err
+		}
+	}
+	return svcCtx.ObjectStore.Delete(ctx, value)
+}
+
+func randomHex(bytesLen int) (string, error) {
+	if bytesLen <= 0 {
+		return "", fmt.Errorf("bytesLen must be positive")
+	}
+	buf := make([]byte, bytesLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func isHTTPURL(value string) bool {
+	v := strings.ToLower(strings.TrimSpace(value))
+	return strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://")
+}
+
+var errStoreNotInitialized = errors.New("object store not initialized")
+
+// resolveImageURL turns a stored image value into something a client can load: external URLs
+// are returned as-is and object keys are presigned for mediaDisplayExpiry.
+func resolveImageURL(ctx context.Context, svcCtx *svc.ServiceContext, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || isHTTPURL(value) {
+		return value, nil
+	}
+	if err := util.ValidateObjectName(value); err != nil {
+		return "", fmt.Errorf("invalid object key: %w", err)
+	}
+	if svcCtx.ObjectStore == nil {
+		return "", errStoreNotInitialized
+	}
+	storeCtx, cancel := context.WithTimeout(ctx, storeOpTimeout)
+	defer cancel()
+	presign, err := svcCtx.ObjectStore.PresignGet(storeCtx, value, mediaDisplayExpiry)
+	if err != nil {
+		return "", err
+	}
+	return presign.URL, nil
+}
@@ -0,0 +1,462 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/config"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// The processing queue is reliable: a claimed job moves to a processing list and gets a lease,
+// and only leaves it once handled. Jobs whose lease ran out, because the instance died or hung,
+// are put back by the next run. The keys share a hash tag so the scripts work in cluster mode;
+// they predate background processing and keep their names so queued jobs survive an upgrade.
+const (
+	mediaQueueKey      = "user:{avatar-process}:queue"
+	mediaProcessingKey = "user:{avatar-process}:processing"
+	mediaLeasesKey     = "user:{avatar-process}:leases"
+	mediaAttemptsKey   = "user:{avatar-process}:attempts"
+
+	mediaConfirmedExpiry    = 24 * time.Hour
+	mediaProcessLease       = 5 * time.Minute
+	mediaProcessMaxAttempts = 3
+	mediaVariantContentType = "image/jpeg"
+)
+
+// claimMediaJobScript moves the oldest job to the processing list under a lease and returns
+// {job, attempts}, counting this claim as an attempt.
+var claimMediaJobScript = redis.NewScript(`
+local job = redis.call("LMOVE", KEYS[1], KEYS[2], "RIGHT", "LEFT")
+if not job then
+	return false
+end
+redis.call("ZADD", KEYS[3], ARGV[1], job)
+local attempts = redis.call("HINCRBY", KEYS[4], job, 1)
+return {job, attempts}
+`)
+
+// finishMediaJobScript releases a claimed job. ARGV[2] = "1" queues it again for a retry.
+var finishMediaJobScript = redis.NewScript(`
+redis.call("LREM", KEYS[2], 1, ARGV[1])
+redis.call("ZREM", KEYS[3], ARGV[1])
+if ARGV[2] == "1" then
+	redis.call("LPUSH", KEYS[1], ARGV[1])
+else
+	redis.call("HDEL", KEYS[4], ARGV[1])
+end
+return 1
+`)
+
+// reapMediaJobsScript puts jobs whose lease started before ARGV[1] back on the queue.
+var reapMediaJobsScript = redis.NewScript(`
+local expired = redis.call("ZRANGEBYSCORE", KEYS[3], "-inf", ARGV[1])
+for _, job in ipairs(expired) do
+	redis.call("ZREM", KEYS[3], job)
+	if redis.call("LREM", KEYS[2], 1, job) > 0 then
+		redis.call("LPUSH", KEYS[1], job)
+	end
+end
+return #expired
+`)
+
+// clearConfirmedScript deletes KEYS[1] only while it still names ARGV[1].
+var clearConfirmedScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+var mediaQueueKeys = []string{mediaQueueKey, mediaProcessingKey, mediaLeasesKey, mediaAttemptsKey}
+
+// avatarVariantSizes are the square sizes, in pixels, every avatar is rendered at. The last one
+// is also what is served when no size is requested.
+var avatarVariantSizes = []int{64, 128, 512}
+
+// avatarVariantPattern matches the key of a processed avatar, avatars/{userId}/{upload}/{size}.jpg.
+var avatarVariantPattern = regexp.MustCompile(`^(avatars/\d+/[^/]+/)(\d+)\.jpg$`)
+
+// backgroundVariantPattern matches the key of a processed background,
+// backgrounds/{userId}/{upload}/image.jpg.
+var backgroundVariantPattern = regexp.MustCompile(`^(backgrounds/\d+/[^/]+/)image\.jpg$`)
+
+const backgroundVariantName = "image.jpg"
+
+// renderAvatar crops and scales an avatar upload to every size in avatarVariantSizes.
+func renderAvatar(data []byte, limits config.MediaLimits) (map[string][]byte, error) {
+	thumbnails, err := util.SquareThumbnails(data, avatarVariantSizes, limits.MaxPixels)
+	if err != nil {
+		return nil, err
+	}
+	variants := make(map[string][]byte, len(thumbnails))
+	for size, thumbnail := range thumbnails {
+		variants[avatarVariantName(size)] = thumbnail
+	}
+	return variants, nil
+}
+
+// renderBackground re-encodes a background upload at its own size, dropping its metadata.
+func renderBackground(data []byte, limits config.MediaLimits) (map[string][]byte, error) {
+	reencoded, err := util.ReencodeImage(data, limits.MaxPixels)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{backgroundVariantName: reencoded}, nil
+}
+
+// mediaJob is one confirmed upload waiting for processing. The payload is also its identity in
+// the processing list, so it carries nothing that changes between attempts. Jobs queued before
+// backgrounds were processed have no slot and are avatars.
+type mediaJob struct {
+	UserID    int64  `json:"userId"`
+	ObjectKey string `json:"objectKey"`
+	Slot      string `json:"slot,omitempty"`
+}
+
+// mediaVariantDir is where the variants of the upload objectKey are written. It is derived from
+// the key alone, so a retried job overwrites its own earlier output.
+func mediaVariantDir(objectKey string) string {
+	return strings.TrimSuffix(objectKey, path.Ext(objectKey)) + "/"
+}
+
+func avatarVariantName(size int) string {
+	return strconv.Itoa(size) + ".jpg"
+}
+
+// avatarForSize maps a stored avatar to the variant closest to size: the smallest one at least
+// that large, or the largest one. 0 picks the largest. Values that are not processed avatars,
+// such as external URLs and the default avatar, are returned as-is.
+func avatarForSize(avatar string, size int32) string {
+	match := avatarVariantPattern.FindStringSubmatch(avatar)
+	if match == nil {
+		return avatar
+	}
+	variant := avatarVariantSizes[len(avatarVariantSizes)-1]
+	if size > 0 {
+		for _, candidate := range avatarVariantSizes {
+			if candidate >= int(size) {
+				variant = candidate
+				break
+			}
+		}
+	}
+	return match[1] + avatarVariantName(variant)
+}
+
+// enqueueMediaProcessing records the job's upload as the user's latest confirmed one in its slot
+// and queues it for ProcessQueuedMedia. The profile keeps its current image until the variants
+// exist.
+func enqueueMediaProcessing(ctx context.Context, svcCtx *svc.ServiceContext, slot *mediaSlot, job mediaJob) error {
+	job.Slot = slot.name
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	if err := svcCtx.Redis.SetexCtx(ctx, slot.confirmedKey(job.UserID), job.ObjectKey,
+		int(mediaConfirmedExpiry/time.Second)); err != nil {
+		return err
+	}
+	_, err = svcCtx.Redis.LpushCtx(ctx, mediaQueueKey, string(payload))
+	return err
+}
+
+// forgetConfirmedMedia drops the user's queued upload in slot, if any, so it does not replace an
+// image set afterwards by other means. The queued job then finds itself superseded.
+func forgetConfirmedMedia(ctx context.Context, svcCtx *svc.ServiceContext, slot *mediaSlot, userID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	_, err := svcCtx.Redis.DelCtx(ctx, slot.confirmedKey(userID))
+	return err
+}
+
+// ProcessQueuedMedia takes up to Avatar.ProcessBatchSize confirmed uploads off the queue and
+// renders each through its slot: avatars into square variants, backgrounds into a re-encoded
+// copy. It returns how many were handled. Every instance may call it: claiming is atomic, so each
+// upload is handled by one instance at a time. A job that fails for a reason other than the image
+// itself is retried, up to mediaProcessMaxAttempts attempts in total, after which the upload is
+// discarded and the profile keeps its image.
+func ProcessQueuedMedia(ctx context.Context, svcCtx *svc.ServiceContext) (int, error) {
+	if svcCtx.ObjectStore == nil {
+		return 0, errStoreNotInitialized
+	}
+	logger := logx.WithContext(ctx)
+
+	// Step 1: Put back jobs abandoned by a crashed or stuck instance.
+	reapCtx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	reaped, err := svcCtx.Redis.ScriptRunCtx(reapCtx, reapMediaJobsScript, mediaQueueKeys,
+		time.Now().Add(-mediaProcessLease).UnixMilli())
+	cancel()
+	if err != nil {
+		return 0, err
+	}
+	if n, _ := reaped.(int64); n > 0 {
+		logger.Infof("process media: requeued %d expired jobs", n)
+	}
+
+	// Step 2: Claim and handle jobs one by one.
+	processed := 0
+	for processed < svcCtx.Config.Avatar.ProcessBatchSize {
+		payload, attempts, err := claimMediaJob(ctx, svcCtx)
+		if errors.Is(err, redis.Nil) {
+			break
+		}
+		if err != nil {
+			return processed, err
+		}
+		processed++
+
+		var job mediaJob
+		if err := json.Unmarshal([]byte(payload), &job); err != nil {
+			logger.Errorf("process media: dropping malformed job %q: %v", payload, err)
+			finishMediaJob(ctx, svcCtx, payload, false)
+			continue
+		}
+		slot, err := mediaSlotByName(job.Slot)
+		if err != nil {
+			logger.Errorf("process media: dropping job of unknown slot %q: %v", payload, err)
+			finishMediaJob(ctx, svcCtx, payload, false)
+			continue
+		}
+		if attempts > mediaProcessMaxAttempts {
+			logger.Errorf("process media: giving up after %d attempts, slot=%s userId=%d key=%s",
+				attempts-1, slot.name, job.UserID, job.ObjectKey)
+			discardMediaUpload(ctx, svcCtx, slot, job)
+			finishMediaJob(ctx, svcCtx, payload, false)
+			continue
+		}
+
+		err = processMedia(ctx, svcCtx, slot, job)
+		switch {
+		case err == nil:
+			logger.Infof("process media: success, slot=%s userId=%d key=%s", slot.name, job.UserID, job.ObjectKey)
+			finishMediaJob(ctx, svcCtx, payload, false)
+		case errors.Is(err, util.ErrInvalidImage):
+			logger.Infof("process media: rejected upload, slot=%s userId=%d key=%s: %v", slot.name, job.UserID, job.ObjectKey, err)
+			discardMediaUpload(ctx, svcCtx, slot, job)
+			finishMediaJob(ctx, svcCtx, payload, false)
+		case attempts < mediaProcessMaxAttempts:
+			logger.Errorf("process media: failed, retrying, slot=%s userId=%d key=%s: %v", slot.name, job.UserID, job.ObjectKey, err)
+			finishMediaJob(ctx, svcCtx, payload, true)
+		default:
+			logger.Errorf("process media: giving up, slot=%s userId=%d key=%s: %v", slot.name, job.UserID, job.ObjectKey, err)
+			discardMediaUpload(ctx, svcCtx, slot, job)
+			finishMediaJob(ctx, svcCtx, payload, false)
+		}
+	}
+	return processed, nil
+}
+
+// claimMediaJob leases the oldest queued job. It returns redis.Nil when the queue is empty.
+func claimMediaJob(ctx context.Context, svcCtx *svc.ServiceContext) (string, int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	result, err := svcCtx.Redis.ScriptRunCtx(ctx, claimMediaJobScript, mediaQueueKeys, time.Now().UnixMilli())
+	if err != nil {
+		return "", 0, err
+	}
+	values, ok := result.([]any)
+	if !ok || len(values) != 2 {
+		return "", 0, fmt.Errorf("unexpected claim result %v", result)
+	}
+	payload, _ := values[0].(string)
+	attempts, _ := values[1].(int64)
+	return payload, attempts, nil
+}
+
+// finishMediaJob releases a claimed job, queueing it again when retry is set. A failure is only
+// logged: the lease runs out and the job is retried, which processing tolerates.
+func finishMediaJob(ctx context.Context, svcCtx *svc.ServiceContext, payload string, retry bool) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	flag := "0"
+	if retry {
+		flag = "1"
+	}
+	if _, err := svcCtx.Redis.ScriptRunCtx(ctx, finishMediaJobScript, mediaQueueKeys, payload, flag); err != nil {
+		logx.WithContext(ctx).Errorf("process media: release job failed, job=%s: %v", payload, err)
+	}
+}
+
+// processMedia renders the variants of an upload and, if it is still the user's latest
+// confirmed upload in the slot, switches the profile to them and deletes the image they replace.
+// The upload itself is never served; it is deleted once handled. Running it twice for a job is
+// harmless.
+func processMedia(ctx context.Context, svcCtx *svc.ServiceContext, slot *mediaSlot, job mediaJob) error {
+	// Step 1: Skip uploads that were superseded or withdrawn while queued.
+	current, err := confirmedMedia(ctx, svcCtx, slot, job.UserID)
+	if err != nil {
+		return err
+	}
+	if current != job.ObjectKey {
+		deleteMediaUpload(ctx, svcCtx, job)
+		return nil
+	}
+
+	// Step 2: Load the upload.
+	limits := slot.limits(svcCtx.Config)
+	data, err := readMediaUpload(ctx, svcCtx, job.ObjectKey, limits.MaxBytes)
+	if errors.Is(err, util.ErrObjectNotFound) {
+		clearConfirmedMedia(ctx, svcCtx, slot, job)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Decode, strip and re-encode. Anything that is not an image fails here.
+	variants, err := slot.render(data, limits)
+	if err != nil {
+		return err
+	}
+	dir := mediaVariantDir(job.ObjectKey)
+	for name, variant := range variants {
+		putCtx, cancel := context.WithTimeout(ctx, storeOpTimeout)
+		err := svcCtx.ObjectStore.Put(putCtx, dir+name, variant, mediaVariantContentType)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Step 4: Point the profile at the variant the slot serves.
+	processed := dir + slot.served
+	applied, previous, err := applyProcessedMedia(ctx, svcCtx, slot, job, processed)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		deleteMediaUpload(ctx, svcCtx, job)
+		return nil
+	}
+	markWritten(ctx, svcCtx, job.UserID)
+	invalidateProfile(ctx, svcCtx, job.UserID)
+	clearConfirmedMedia(ctx, svcCtx, slot, job)
+
+	// Step 5: Drop the original upload and the image it replaced. Shared defaults and external
+	// URLs live elsewhere and are never touched.
+	logger := logx.WithContext(ctx)
+	deleteCtx, cancel := context.WithTimeout(ctx, storeOpTimeout)
+	defer cancel()
+	if err := svcCtx.ObjectStore.Delete(deleteCtx, job.ObjectKey); err != nil {
+		logger.Errorf("process media: delete upload failed, key=%s: %v", job.ObjectKey, err)
+	}
+	userPrefix := slot.userPrefix(strconv.FormatInt(job.UserID, 10))
+	if previous != processed && strings.HasPrefix(previous, userPrefix) {
+		if err := deleteMediaObjects(ctx, svcCtx, previous); err != nil {
+			logger.Errorf("process media: delete previous %s failed, key=%s: %v", slot.name, previous, err)
+		}
+	}
+	return nil
+}
+
+// applyProcessedMedia sets the user's image in slot to processed if job is still their latest
+// confirmed upload there, and returns the image it replaced. The confirmed upload is read under
+// the profile row lock, so an image set directly in the meantime, which withdraws it first, wins.
+func applyProcessedMedia(ctx context.Context, svcCtx *svc.ServiceContext, slot *mediaSlot, job mediaJob, processed string) (bool, string, error) {
+	execCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	var applied bool
+	var previous string
+	err := svcCtx.WriteConn.TransactCtx(execCtx, func(txCtx context.Context, session sqlx.Session) error {
+		var current struct {
+			Value sql.NullString `db:"value"`
+		}
+		if err := session.QueryRowCtx(txCtx, &current,
+			`SELECT `+slot.column+` AS value FROM t_user_profile WHERE user_id = ? FOR UPDATE`, job.UserID); err != nil {
+			return err
+		}
+		confirmed, err := confirmedMedia(ctx, svcCtx, slot, job.UserID)
+		if err != nil {
+			return err
+		}
+		if confirmed != job.ObjectKey {
+			return nil
+		}
+		previous = nullString(current.Value)
+		if _, err := session.ExecCtx(txCtx,
+			`UPDATE t_user_profile SET `+slot.column+` = ? WHERE user_id = ?`, processed, job.UserID); err != nil {
+			return err
+		}
+		applied = true
+		return nil
+	})
+	if errors.Is(err, sqlx.ErrNotFound) {
+		return false, "", nil
+	}
+	return applied, previous, err
+}
+
+func confirmedMedia(ctx context.Context, svcCtx *svc.ServiceContext, slot *mediaSlot, userID int64) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	return svcCtx.Redis.GetCtx(ctx, slot.confirmedKey(userID))
+}
+
+// clearConfirmedMedia forgets job as the user's latest confirmed upload in slot, unless a newer
+// one took its place.
+func clearConfirmedMedia(ctx context.Context, svcCtx *svc.ServiceContext, slot *mediaSlot, job mediaJob) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	if _, err := svcCtx.Redis.ScriptRunCtx(ctx, clearConfirmedScript,
+		[]string{slot.confirmedKey(job.UserID)}, job.ObjectKey); err != nil {
+		logx.WithContext(ctx).Errorf("process media: clear confirmed upload failed, slot=%s userId=%d: %v", slot.name, job.UserID, err)
+	}
+}
+
+// discardMediaUpload gives up on an upload: it is deleted with any variants already written and
+// the profile keeps the image it had.
+func discardMediaUpload(ctx context.Context, svcCtx *svc.ServiceContext, slot *mediaSlot, job mediaJob) {
+	clearConfirmedMedia(ctx, svcCtx, slot, job)
+	deleteMediaUpload(ctx, svcCtx, job)
+}
+
+// deleteMediaUpload removes an upload and its variant directory. Failures are only logged: the
+// objects are removed with the account at the latest.
+func deleteMediaUpload(ctx context.Context, svcCtx *svc.ServiceContext, job mediaJob) {
+	logger := logx.WithContext(ctx)
+	deleteCtx, cancel := context.WithTimeout(ctx, storeOpTimeout)
+	defer cancel()
+	if err := svcCtx.ObjectStore.Delete(deleteCtx, job.ObjectKey); err != nil {
+		logger.Errorf("process media: delete upload failed, key=%s: %v", job.ObjectKey, err)
+	}
+	if _, err := util.DeletePrefix(deleteCtx, svcCtx.ObjectStore, mediaVariantDir(job.ObjectKey)); err != nil {
+		logger.Errorf("process media: delete variants failed, key=%s: %v", job.ObjectKey, err)
+	}
+}
+
+// readMediaUpload reads an upload, refusing more than maxBytes in case the store did not enforce
+// the upload policy.
+func readMediaUpload(ctx context.Context, svcCtx *svc.ServiceContext, objectKey string, maxBytes int64) ([]byte, error) {
+	getCtx, cancel := context.WithTimeout(ctx, storeOpTimeout)
+	defer cancel()
+	body, err := svcCtx.ObjectStore.Get(getCtx, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	data, err := io.ReadAll(io.LimitReader(body, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("%w: larger than %d bytes", util.ErrInvalidImage, maxBytes)
+	}
+	return data, nil
+}
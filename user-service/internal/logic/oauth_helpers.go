@@ -0,0 +1,220 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	maxOAuthIDLength       = 100
+	maxOAuthNicknameLength = 100
+	maxOAuthAvatarLength   = 500
+	maxOAuthTokenLength    = 500
+	maxProfileNickname     = 50
+	defaultAvatarKey       = "avatars/default_avatar.jpg"
+)
+
+var platformRegex = regexp.MustCompile(`^[a-z0-9_]{1,20}$`)
+
+// oauthBinding is one row of t_user_oauth.
+type oauthBinding struct {
+	ID        int64          `db:"id"`
+	UserID    int64          `db:"user_id"`
+	Platform  string         `db:"platform"`
+	Nickname  sql.NullString `db:"nickname"`
+	Avatar    sql.NullString `db:"avatar"`
+	CreatedAt sql.NullTime   `db:"created_at"`
+}
+
+// normalizeOAuthIdentity validates the identity a provider returned and trims oversized optional fields.
+func normalizeOAuthIdentity(identity *userpb.OAuthIdentity) (*userpb.OAuthIdentity, error) {
+	if identity == nil {
+		return nil, invalidArgument("identity", "identity is required")
+	}
+	out := &userpb.OAuthIdentity{
+		Platform:       strings.ToLower(strings.TrimSpace(identity.Platform)),
+		Openid:         strings.TrimSpace(identity.Openid),
+		Unionid:        strings.TrimSpace(identity.Unionid),
+		Nickname:       truncateRunes(strings.TrimSpace(identity.Nickname), maxOAuthNicknameLength),
+		Avatar:         strings.TrimSpace(identity.Avatar),
+		AccessToken:    identity.AccessToken,
+		RefreshToken:   identity.RefreshToken,
+		TokenExpiresAt: identity.TokenExpiresAt,
+		RawData:        identity.RawData,
+	}
+	if !platformRegex.MatchString(out.Platform) {
+		return nil, invalidArgument("identity.platform", "invalid platform")
+	}
+	if out.Openid == "" || len(out.Openid) > maxOAuthIDLength {
+		return nil, invalidArgument("identity.openid", "openid is required and must be at most 100 characters")
+	}
+	if len(out.Unionid) > maxOAuthIDLength {
+		return nil, invalidArgument("identity.unionid", "unionid must be at most 100 characters")
+	}
+	if len(out.Avatar) > maxOAuthAvatarLength {
+		out.Avatar = ""
+	}
+	if len(out.AccessToken) > maxOAuthTokenLength {
+		out.AccessToken = ""
+	}
+	if len(out.RefreshToken) > maxOAuthTokenLength {
+		out.RefreshToken = ""
+	}
+	if out.RawData != "" && !json.Valid([]byte(out.RawData)) {
+		out.RawData = ""
+	}
+	return out, nil
+}
+
+// findOAuthBinding looks a binding up by openid, then by unionid so an account linked through
+// another app of the same provider is still recognised.
+func findOAuthBinding(ctx context.Context, conn sqlx.SqlConn, identity *userpb.OAuthIdentity) (*oauthBinding, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+
+	var binding oauthBinding
+	err := conn.QueryRowCtx(queryCtx, &binding,
+		`SELECT id, user_id, platform, nickname, avatar, created_at FROM t_user_oauth
+		WHERE platform = ? AND openid = ? LIMIT 1`, identity.Platform, identity.Openid)
+	if errors.Is(err, sqlx.ErrNotFound) && identity.Unionid != "" {
+		err = conn.QueryRowCtx(queryCtx, &binding,
+			`SELECT id, user_id, platform, nickname, avatar, created_at FROM t_user_oauth
+			WHERE platform = ? AND unionid = ? LIMIT 1`, identity.Platform, identity.Unionid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &binding, nil
+}
+
+// insertOAuthBinding stores identity for userID inside an existing transaction.
+func insertOAuthBinding(ctx context.Context, session sqlx.Session, userID int64, identity *userpb.OAuthIdentity) error {
+	_, err := session.ExecCtx(ctx,
+		`INSERT INTO t_user_oauth (user_id, platform, openid, unionid, nickname, avatar,
+		access_token, refresh_token, token_expires_at, raw_data) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		userID, identity.Platform, identity.Openid, nullableString(identity.Unionid),
+		nullableString(identity.Nickname), nullableString(identity.Avatar),
+		nullableString(identity.AccessToken), nullableString(identity.RefreshToken),
+		oauthExpiry(identity.TokenExpiresAt), nullableString(identity.RawData))
+	return err
+}
+
+// refreshOAuthBinding updates the provider data stored with an existing binding.
+func refreshOAuthBinding(ctx context.Context, conn sqlx.SqlConn, bindingID int64, identity *userpb.OAuthIdentity) error {
+	execCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	_, err := conn.ExecCtx(execCtx,
+		`UPDATE t_user_oauth SET openid = ?, unionid = COALESCE(?, unionid), nickname = ?, avatar = ?,
+		access_token = ?, refresh_token = ?, token_expires_at = ?, raw_data = COALESCE(?, raw_data)
+		WHERE id = ?`,
+		identity.Openid, nullableString(identity.Unionid), nullableString(identity.Nickname),
+		nullableString(identity.Avatar), nullableString(identity.AccessToken),
+		nullableString(identity.RefreshToken), oauthExpiry(identity.TokenExpiresAt),
+		nullableString(identity.RawData), bindingID)
+	return err
+}
+
+// listOAuthBindings returns the platforms bound to userID, oldest first.
+func listOAuthBindings(ctx context.Context, conn sqlx.SqlConn, userID int64) (*userpb.OAuthBindingsResponse, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+
+	var rows []oauthBinding
+	if err := conn.QueryRowsCtx(queryCtx, &rows,
+		`SELECT id, user_id, platform, nickname, avatar, created_at FROM t_user_oauth
+		WHERE user_id = ? ORDER BY created_at, id`, userID); err != nil {
+		return nil, err
+	}
+	resp := &userpb.OAuthBindingsResponse{Bindings: make([]*userpb.OAuthBinding, 0, len(rows))}
+	for _, row := range rows {
+		resp.Bindings = append(resp.Bindings, &userpb.OAuthBinding{
+			Platform:  row.Platform,
+			Nickname:  nullString(row.Nickname),
+			Avatar:    nullString(row.Avatar),
+			CreatedAt: formatTime(row.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// lockAccount takes the row lock that serializes changes to a user's sign-in methods.
+func lockAccount(ctx context.Context, session sqlx.Session, userID int64) error {
+	var id int64
+	return session.QueryRowCtx(ctx, &id, `SELECT id FROM t_user WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, userID)
+}
+
+// ensureSignInMethod returns errLastSignInMethod when userID has no way left to sign in: no
+// password they chose, no third-party binding and no verified phone or email to reset the
+// password through. It runs after the removal, in the same transaction, so the caller rolls back.
+func ensureSignInMethod(ctx context.Context, session sqlx.Session, userID int64) error {
+	var methods struct {
+		PasswordSet int   `db:"password_set"`
+		OAuth       int64 `db:"oauth"`
+		Identifiers int64 `db:"identifiers"`
+	}
+	if err := session.QueryRowCtx(ctx, &methods,
+		`SELECT u.password_set,
+			(SELECT COUNT(*) FROM t_user_oauth o WHERE o.user_id = u.id) AS oauth,
+			(SELECT COUNT(*) FROM t_user_auth a WHERE a.user_id = u.id AND a.verified = 1) AS identifiers
+		FROM t_user u WHERE u.id = ?`, userID); err != nil {
+		return err
+	}
+	if methods.PasswordSet == 0 && methods.OAuth == 0 && methods.Identifiers == 0 {
+		return errLastSignInMethod
+	}
+	return nil
+}
+
+// queryUserRoles loads the roles granted to a user, falling back to RoleUser when none are stored.
+func queryUserRoles(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) ([]string, error) {
+	var roles []string
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	if err := svcCtx.ReadConn.QueryRowsCtx(queryCtx, &roles,
+		`SELECT role FROM t_user_role WHERE user_id = ? ORDER BY role`, userID); err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		roles = []string{RoleUser}
+	}
+	return roles, nil
+}
+
+// oauthUsername builds a username for an account created through platform.
+// A 20 character platform plus the separator and suffix stays within MaxUsernameLength.
+func oauthUsername(platform string) (string, error) {
+	suffix, err := randomHex(5)
+	if err != nil {
+		return "", err
+	}
+	return platform + "_" + suffix, nil
+}
+
+func nullableString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func oauthExpiry(unix int64) sql.NullTime {
+	if unix <= 0 {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: time.Unix(unix, 0), Valid: true}
+}
+
+func truncateRunes(value string, limit int) string {
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+	return string([]rune(value)[:limit])
+}
@@ -0,0 +1,175 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// oauthCreateAttempts bounds retries when a generated username collides.
+const oauthCreateAttempts = 3
+
+type OAuthLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewOAuthLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OAuthLoginLogic {
+	return &OAuthLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// OAuthLogin finds the user bound to a third-party identity, creating one on first login.
+func (l *OAuthLoginLogic) OAuthLogin(in *userpb.OAuthRequest) (*userpb.OAuthLoginResponse, error) {
+	// Step 1: Validate the identity returned by the provider.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	identity, err := normalizeOAuthIdentity(in.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; attempt < oauthCreateAttempts; attempt++ {
+		// Step 2: Log in through an existing binding.
+		// Use WriteConn so an account created moments ago is not missed because of replication lag.
+		binding, err := findOAuthBinding(l.ctx, l.svcCtx.WriteConn, identity)
+		switch {
+		case err == nil:
+			resp, err := l.loginBound(binding, identity)
+			if !errors.Is(err, sqlx.ErrNotFound) {
+				return resp, err
+			}
+			// The bound user no longer exists; drop the stale binding and start over.
+			if err := l.deleteBinding(binding.ID); err != nil {
+				l.Errorf("oauth login: delete stale binding failed: %v", err)
+				return nil, errInternal
+			}
+		case !errors.Is(err, sqlx.ErrNotFound):
+			l.Errorf("oauth login: query binding failed: %v", err)
+			return nil, errInternal
+		}
+
+		// Step 3: First login, create the account and the binding together.
+		resp, err := l.createAccount(identity)
+		if err == nil {
+			return resp, nil
+		}
+		if !isDuplicateKey(err) {
+			l.Errorf("oauth login: create account failed: %v", err)
+			return nil, errInternal
+		}
+		// Either a concurrent login bound this identity or the username collided; look again.
+	}
+
+	l.Errorf("oauth login: gave up after %d attempts, platform=%s", oauthCreateAttempts, identity.Platform)
+	return nil, errInternal
+}
+
+// loginBound completes a login through binding. It returns sqlx.ErrNotFound when the bound user is gone.
+func (l *OAuthLoginLogic) loginBound(binding *oauthBinding, identity *userpb.OAuthIdentity) (*userpb.OAuthLoginResponse, error) {
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var user struct {
+		Username string `db:"username"`
+		Status   int    `db:"status"`
+	}
+	// Deactivated accounts keep their bindings until purged, so they are refused here rather than
+	// treated as a stale binding and replaced by a new account.
+	if err := l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &user,
+		`SELECT username, status FROM t_user WHERE id = ? AND (deleted_at IS NULL OR status = ?) LIMIT 1`,
+		binding.UserID, StatusDeactivated); err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			return nil, err
+		}
+		l.Errorf("oauth login: query user failed: %v", err)
+		return nil, errInternal
+	}
+	if user.Status != StatusActive {
+		l.Infof("oauth login: account disabled, userId=%d status=%d", binding.UserID, user.Status)
+		return nil, errAccountDisabled
+	}
+
+	if err := refreshOAuthBinding(l.ctx, l.svcCtx.WriteConn, binding.ID, identity); err != nil {
+		// Stale provider data does not block the login.
+		l.Errorf("oauth login: refresh binding failed: %v", err)
+	}
+
+	roles, err := queryUserRoles(l.ctx, l.svcCtx, binding.UserID)
+	if err != nil {
+		l.Errorf("oauth login: query roles failed: %v", err)
+		return nil, errInternal
+	}
+
+	l.Infof("oauth login: success, platform=%s userId=%d", identity.Platform, binding.UserID)
+	return &userpb.OAuthLoginResponse{
+		UserId:   strconv.FormatInt(binding.UserID, 10),
+		Username: user.Username,
+		Roles:    roles,
+	}, nil
+}
+
+// createAccount inserts a user, its profile and the binding in one transaction.
+// The account gets a random password, marked as not set, so the binding stays its only way in
+// until the user sets a real one through a verified phone or email.
+func (l *OAuthLoginLogic) createAccount(identity *userpb.OAuthIdentity) (*userpb.OAuthLoginResponse, error) {
+	username, err := oauthUsername(identity.Platform)
+	if err != nil {
+		return nil, err
+	}
+	password, err := randomHex(32)
+	if err != nil {
+		return nil, err
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := generateUserID()
+	insertCtx, cancel := context.WithTimeout(context.Background(), dbQueryTimeout)
+	defer cancel()
+	err = l.svcCtx.WriteConn.TransactCtx(insertCtx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := session.ExecCtx(ctx,
+			`INSERT INTO t_user (id, username, password, password_set, status) VALUES (?, ?, ?, 0, 1)`,
+			userID, username, string(hashed)); err != nil {
+			return err
+		}
+		if _, err := session.ExecCtx(ctx,
+			`INSERT INTO t_user_profile (user_id, nickname, avatar) VALUES (?, ?, ?)`,
+			userID, nullableString(truncateRunes(identity.Nickname, maxProfileNickname)), defaultAvatarKey); err != nil {
+			return err
+		}
+		return insertOAuthBinding(ctx, session, userID, identity)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	markWritten(l.ctx, l.svcCtx, userID)
+	l.Infof("oauth login: account created, platform=%s userId=%d", identity.Platform, userID)
+	return &userpb.OAuthLoginResponse{
+		UserId:   strconv.FormatInt(userID, 10),
+		Username: username,
+		Roles:    []string{RoleUser},
+		Created:  true,
+	}, nil
+}
+
+func (l *OAuthLoginLogic) deleteBinding(id int64) error {
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	_, err := l.svcCtx.WriteConn.ExecCtx(execCtx, `DELETE FROM t_user_oauth WHERE id = ?`, id)
+	return err
+}
@@ -0,0 +1,142 @@
+package logic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	resetTokenPrefix    = "user:pwreset:token:"
+	resetUserPrefix     = "user:pwreset:user:"
+	resetCooldownPrefix = "user:pwreset:cooldown:"
+	resetTokenBytes     = 32
+)
+
+// resetTokenKey stores tokens by hash, so a redis dump does not hand out working reset links.
+func resetTokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return resetTokenPrefix + hex.EncodeToString(sum[:])
+}
+
+func resetUserKey(userID int64) string {
+	return resetUserPrefix + strconv.FormatInt(userID, 10)
+}
+
+// reserveResetSend enforces the resend gap. It reports false when a token was sent recently.
+func reserveResetSend(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) (bool, error) {
+	seconds := svcCtx.Config.PasswordReset.ResendSeconds
+	if seconds <= 0 {
+		return true, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	return svcCtx.Redis.SetnxExCtx(ctx, resetCooldownPrefix+strconv.FormatInt(userID, 10), "1", seconds)
+}
+
+// issueResetToken creates a token for userID. Any earlier token of the user stops working.
+func issueResetToken(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) (string, error) {
+	token, err := randomHex(resetTokenBytes)
+	if err != nil {
+		return "", err
+	}
+	if err := discardResetToken(ctx, svcCtx, userID); err != nil {
+		return "", err
+	}
+
+	ttl := svcCtx.Config.PasswordReset.TokenTTLSeconds
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	key := resetTokenKey(token)
+	if err := svcCtx.Redis.SetexCtx(ctx, key, strconv.FormatInt(userID, 10), ttl); err != nil {
+		return "", err
+	}
+	if err := svcCtx.Redis.SetexCtx(ctx, resetUserKey(userID), key, ttl); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// peekResetToken returns the user token was issued to without spending it.
+// It returns errResetTokenInvalid when the token is unknown or expired.
+func peekResetToken(ctx context.Context, svcCtx *svc.ServiceContext, token string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	value, err := svcCtx.Redis.GetCtx(ctx, resetTokenKey(token))
+	if err != nil {
+		return 0, err
+	}
+	return parseResetTokenValue(value)
+}
+
+// consumeResetToken deletes token and returns the user it was issued to.
+// It returns errResetTokenInvalid when the token is unknown, expired or already used.
+func consumeResetToken(ctx context.Context, svcCtx *svc.ServiceContext, token string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	value, err := svcCtx.Redis.GetDelCtx(ctx, resetTokenKey(token))
+	if err != nil {
+		return 0, err
+	}
+	userID, err := parseResetTokenValue(value)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := svcCtx.Redis.DelCtx(ctx, resetUserKey(userID)); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+func parseResetTokenValue(value string) (int64, error) {
+	userID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errResetTokenInvalid
+	}
+	return userID, nil
+}
+
+// discardResetToken invalidates the pending reset token of userID, if any.
+func discardResetToken(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	previous, err := svcCtx.Redis.GetDelCtx(ctx, resetUserKey(userID))
+	if err != nil || previous == "" {
+		return err
+	}
+	_, err = svcCtx.Redis.DelCtx(ctx, previous)
+	return err
+}
+
+// setPassword stores a new password hash for an active account and returns its username.
+// It returns errUserNotFound when the account is missing, deleted or not active.
+func setPassword(ctx context.Context, svcCtx *svc.ServiceContext, userID int64, password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	var username string
+	err = svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		if err := session.QueryRowCtx(ctx, &username,
+			`SELECT username FROM t_user WHERE id = ? AND status = ? AND deleted_at IS NULL FOR UPDATE`,
+			userID, StatusActive); err != nil {
+			return err
+		}
+		_, err := session.ExecCtx(ctx, `UPDATE t_user SET password = ?, password_set = 1 WHERE id = ?`, string(hashed), userID)
+		return err
+	})
+	if errors.Is(err, sqlx.ErrNotFound) {
+		return "", errUserNotFound
+	}
+	return username, err
+}
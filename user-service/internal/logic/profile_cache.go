@@ -0,0 +1,120 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const profileCachePrefix = "user:profile:"
+
+const profileColumns = `user_id, nickname, avatar, gender, birthday, bio, background_image, country, province, city,
+       school, major, graduation_year, created_at, updated_at`
+
+// profileRecord is one t_user_profile row. It is also the value stored in the profile cache.
+type profileRecord struct {
+	UserID          int64          `db:"user_id"`
+	Nickname        sql.NullString `db:"nickname"`
+	Avatar          sql.NullString `db:"avatar"`
+	Gender          sql.NullInt64  `db:"gender"`
+	Birthday        sql.NullTime   `db:"birthday"`
+	Bio             sql.NullString `db:"bio"`
+	BackgroundImage sql.NullString `db:"background_image"`
+	Country         sql.NullString `db:"country"`
+	Province        sql.NullString `db:"province"`
+	City            sql.NullString `db:"city"`
+	School          sql.NullString `db:"school"`
+	Major           sql.NullString `db:"major"`
+	GraduationYear  sql.NullInt64  `db:"graduation_year"`
+	CreatedAt       sql.NullTime   `db:"created_at"`
+	UpdatedAt       sql.NullTime   `db:"updated_at"`
+}
+
+func (r *profileRecord) toProto() *userpb.UserDataResponse {
+	return &userpb.UserDataResponse{
+		UserId:          strconv.FormatInt(r.UserID, 10),
+		Nickname:        nullString(r.Nickname),
+		Avatar:          nullString(r.Avatar),
+		Gender:          nullInt32(r.Gender),
+		Birthday:        formatDate(r.Birthday),
+		Bio:             nullString(r.Bio),
+		BackgroundImage: nullString(r.BackgroundImage),
+		Country:         nullString(r.Country),
+		Province:        nullString(r.Province),
+		City:            nullString(r.City),
+		School:          nullString(r.School),
+		Major:           nullString(r.Major),
+		GraduationYear:  nullInt32(r.GraduationYear),
+		CreatedAt:       formatTime(r.CreatedAt),
+		UpdatedAt:       formatTime(r.UpdatedAt),
+	}
+}
+
+func profileCacheKey(userID int64) string {
+	return profileCachePrefix + strconv.FormatInt(userID, 10)
+}
+
+// queryProfile reads the profile row from conn. It returns sqlx.ErrNotFound when the row is missing.
+func queryProfile(ctx context.Context, conn sqlx.SqlConn, userID int64, record *profileRecord) error {
+	queryCtx, cancel := context.WithTimeout(ctx, dbQueryTimeout)
+	defer cancel()
+	return conn.QueryRowCtx(queryCtx, record, `SELECT `+profileColumns+`
+FROM t_user_profile
+WHERE user_id = ?
+LIMIT 1`, userID)
+}
+
+// loadProfile returns the profile for userID, going through the profile cache when it is enabled.
+// Concurrent misses for the same user share a single query, and a missing row is remembered as
+// not found for a short while. Misses are read through readConnFor, so a cache refilled right
+// after a write does not pick up a stale replica row. It returns sqlx.ErrNotFound when the
+// profile does not exist.
+func loadProfile(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) (*profileRecord, error) {
+	var record profileRecord
+	if svcCtx.ProfileCache == nil {
+		if err := queryProfile(ctx, readConnFor(ctx, svcCtx, userID), userID, &record); err != nil {
+			return nil, err
+		}
+		return &record, nil
+	}
+
+	err := svcCtx.ProfileCache.TakeCtx(ctx, &record, profileCacheKey(userID), func(val any) error {
+		return queryProfile(ctx, readConnFor(ctx, svcCtx, userID), userID, val.(*profileRecord))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// invalidateProfile drops the cached profile of userID. Call it after the write is committed.
+// Failed deletes are retried in the background by the cache node, so errors are only logged.
+func invalidateProfile(ctx context.Context, svcCtx *svc.ServiceContext, userID int64) {
+	if svcCtx.ProfileCache == nil {
+		return
+	}
+	delCtx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	if err := svcCtx.ProfileCache.DelCtx(delCtx, profileCacheKey(userID)); err != nil {
+		logx.WithContext(ctx).Errorf("invalidate profile cache failed, userId=%d: %v", userID, err)
+	}
+}
+
+// refreshProfile stores a row just read from the primary, so the next read does not repopulate
+// the cache from a lagging replica.
+func refreshProfile(ctx context.Context, svcCtx *svc.ServiceContext, record *profileRecord) {
+	if svcCtx.ProfileCache == nil {
+		return
+	}
+	setCtx, cancel := context.WithTimeout(ctx, redisOpTimeout)
+	defer cancel()
+	if err := svcCtx.ProfileCache.SetCtx(setCtx, profileCacheKey(record.UserID), record); err != nil {
+		logx.WithContext(ctx).Errorf("refresh profile cache failed, userId=%d: %v", record.UserID, err)
+	}
+}
@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ReactivateAccountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReactivateAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReactivateAccountLogic {
+	return &ReactivateAccountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReactivateAccount restores a deactivated account by password while the grace period lasts.
+func (l *ReactivateAccountLogic) ReactivateAccount(in *userpb.ReactivateAccountRequest) (*userpb.ReactivateAccountResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	username := normalizeLogin(in.Username)
+	if username == "" || in.Password == "" {
+		return nil, errInvalidCredentials
+	}
+
+	// Step 2: Find the deactivated account.
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var record accountRecord
+	err := l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &record,
+		`SELECT id, password, status FROM t_user
+		WHERE username = ? AND status = ? AND deleted_at IS NOT NULL AND purged_at IS NULL LIMIT 1`,
+		username, StatusDeactivated)
+	if err != nil && !errors.Is(err, sqlx.ErrNotFound) {
+		l.Errorf("reactivate account: query failed: %v", err)
+		return nil, errInternal
+	}
+	found := err == nil
+	subject := loginLockSubject(username)
+	if found {
+		subject = accountLockSubject(record.ID)
+	}
+
+	// Step 3: Check the password under the login lockout, so reactivation cannot be used to
+	// guess passwords.
+	remaining, err := lockRemaining(l.ctx, l.svcCtx, subject)
+	if err != nil {
+		l.Errorf("reactivate account: lockout check failed: %v", err)
+	} else if remaining > 0 {
+		return nil, accountLocked(remaining)
+	}
+	if !found {
+		l.Infof("reactivate account: no deactivated account, username=%s", username)
+		l.recordFailure(subject)
+		return nil, errInvalidCredentials
+	}
+	if bcrypt.CompareHashAndPassword([]byte(record.Hash), []byte(in.Password)) != nil {
+		l.Infof("reactivate account: incorrect password, username=%s", username)
+		l.recordFailure(subject)
+		return nil, errInvalidCredentials
+	}
+
+	// Step 4: Restore the account if it is still inside the grace period.
+	result, err := l.svcCtx.WriteConn.ExecCtx(queryCtx,
+		`UPDATE t_user SET status = ?, deleted_at = NULL
+		WHERE id = ? AND status = ? AND purged_at IS NULL AND deleted_at > NOW() - INTERVAL ? SECOND`,
+		StatusActive, record.ID, StatusDeactivated, l.svcCtx.Config.Account.GraceSeconds)
+	if err != nil {
+		l.Errorf("reactivate account: update failed: %v", err)
+		return nil, errInternal
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		l.Infof("reactivate account: grace period over, userId=%d", record.ID)
+		return nil, errGracePeriodExpired
+	}
+	markWritten(l.ctx, l.svcCtx, record.ID)
+	if err := clearLoginFailures(l.ctx, l.svcCtx, subject); err != nil {
+		l.Errorf("reactivate account: reset lockout failed: %v", err)
+	}
+
+	roles, err := queryUserRoles(l.ctx, l.svcCtx, record.ID)
+	if err != nil {
+		l.Errorf("reactivate account: query roles failed: %v", err)
+		return nil, errInternal
+	}
+
+	l.Infof("reactivate account: success, username=%s userId=%d", username, record.ID)
+	return &userpb.ReactivateAccountResponse{
+		UserId:   strconv.FormatInt(record.ID, 10),
+		Username: username,
+		Roles:    roles,
+	}, nil
+}
+
+// recordFailure counts a failed attempt towards the login lockout; redis errors are logged, not returned.
+func (l *ReactivateAccountLogic) recordFailure(subject string) {
+	if _, err := recordLoginFailure(l.ctx, l.svcCtx, subject); err != nil {
+		l.Errorf("reactivate account: record failure failed: %v", err)
+	}
+}
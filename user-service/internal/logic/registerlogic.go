@@ -30,40 +30,49 @@ func NewRegisterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Register
 
 func (l *RegisterLogic) Register(in *userpb.RegisterRequest) (*userpb.RegisterResponse, error) {
 	if in == nil {
-		return &userpb.RegisterResponse{Code: CodeInvalidParam}, nil
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeInvalidParam},
+			invalidArgument("request", "request is required"))
 	}
 	username := strings.TrimSpace(in.Username)
 	password := in.Password
-	if username == "" || password == "" {
-		return &userpb.RegisterResponse{Code: CodeInvalidParam}, nil
+	if username == "" {
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeInvalidParam},
+			invalidArgument("username", "username is required"))
 	}
-	if err := validateUsername(username); err != nil {
+	if password == "" {
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeInvalidParam},
+			invalidArgument("password", "password is required"))
+	}
+	username, err := validateUsername(l.svcCtx, username)
+	if err != nil {
 		l.Infof("register: invalid username: %v", err)
-		return &userpb.RegisterResponse{Code: CodeInvalidParam}, nil
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeInvalidParam},
+			invalidArgument("username", err.Error()))
 	}
-	if err := validatePassword(password); err != nil {
+	if err := validatePassword(l.svcCtx, password, username); err != nil {
 		l.Infof("register: invalid password: %v", err)
-		return &userpb.RegisterResponse{Code: CodeInvalidParam}, nil
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeInvalidParam},
+			invalidArgument("password", err.Error()))
 	}
 
 	var count int64
 	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
 	defer cancel()
 	// Use WriteConn for existence check to avoid false negatives from replication lag.
-	err := l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &count,
+	err = l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &count,
 		`SELECT COUNT(1) FROM t_user WHERE username = ? AND deleted_at IS NULL`, username)
 	if err != nil {
 		l.Errorf("register: query user failed: %v", err)
-		return &userpb.RegisterResponse{Code: CodeInternal}, nil
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeInternal}, errInternal)
 	}
 	if count > 0 {
-		return &userpb.RegisterResponse{Code: CodeAlreadyExists}, nil
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeAlreadyExists}, errUsernameTaken)
 	}
 
 	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		l.Errorf("register: hash password failed: %v", err)
-		return nil, err
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeInternal}, errInternal)
 	}
 
 	userID := generateUserID()
@@ -84,12 +93,13 @@ func (l *RegisterLogic) Register(in *userpb.RegisterRequest) (*userpb.RegisterRe
 	})
 	if err != nil {
 		if isDuplicateKey(err) {
-			return &userpb.RegisterResponse{Code: CodeAlreadyExists}, nil
+			return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeAlreadyExists}, errUsernameTaken)
 		}
 		l.Errorf("register: insert user failed: %v", err)
-		return &userpb.RegisterResponse{Code: CodeInternal}, nil
+		return legacyResult(l.ctx, &userpb.RegisterResponse{Code: CodeInternal}, errInternal)
 	}
 
+	markWritten(l.ctx, l.svcCtx, userID)
 	l.Infof("register: success, username=%s userId=%d", username, userID)
 	return &userpb.RegisterResponse{Code: CodeSuccess}, nil
 }
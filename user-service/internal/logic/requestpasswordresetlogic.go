@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"google.golang.org/grpc/codes"
+)
+
+type RequestPasswordResetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRequestPasswordResetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestPasswordResetLogic {
+	return &RequestPasswordResetLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RequestPasswordReset sends a reset token to a verified email or phone without revealing whether the account exists.
+func (l *RequestPasswordResetLogic) RequestPasswordReset(in *userpb.PasswordResetRequest) (*userpb.PasswordResetResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	login := normalizeLogin(in.Login)
+	if login == "" {
+		return nil, invalidArgument("login", "login is required")
+	}
+
+	// Step 2: Find the account and where to send the token. Unknown accounts and accounts without
+	// a verified email or phone get the same answer as a successful request.
+	dest, err := l.findDestination(login)
+	if err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			l.Infof("request password reset: no reachable account, login=%s", login)
+			return &userpb.PasswordResetResponse{}, nil
+		}
+		l.Errorf("request password reset: query failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 3: Throttle per account, silently for the same reason.
+	ok, err := reserveResetSend(l.ctx, l.svcCtx, dest.UserID)
+	if err != nil {
+		l.Errorf("request password reset: cooldown check failed: %v", err)
+		return nil, errInternal
+	}
+	if !ok {
+		l.Infof("request password reset: token sent recently, userId=%d", dest.UserID)
+		return &userpb.PasswordResetResponse{}, nil
+	}
+
+	// Step 4: Issue and deliver the token.
+	token, err := issueResetToken(l.ctx, l.svcCtx, dest.UserID)
+	if err != nil {
+		l.Errorf("request password reset: store token failed: %v", err)
+		return nil, errInternal
+	}
+	if err := l.svcCtx.CodeSender.Send(l.ctx, util.CodePurposePasswordReset, authChannel(dest.AuthType), dest.Identifier, token); err != nil {
+		l.Errorf("request password reset: send token failed: %v", err)
+		return nil, newStatusError(codes.Unavailable, ReasonInternal, "failed to send password reset token")
+	}
+
+	l.Infof("request password reset: token sent, userId=%d authType=%d", dest.UserID, dest.AuthType)
+	return &userpb.PasswordResetResponse{}, nil
+}
+
+type resetDestination struct {
+	UserID     int64  `db:"user_id"`
+	AuthType   int32  `db:"auth_type"`
+	Identifier string `db:"identifier"`
+}
+
+// findDestination resolves login like VerifyPassword does. A login that is itself a verified email
+// or phone receives the token; a username receives it on its verified email, else its phone.
+func (l *RequestPasswordResetLogic) findDestination(login string) (*resetDestination, error) {
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+
+	var dest resetDestination
+	if authType, identifier, ok := detectLoginIdentifier(login); ok {
+		err := l.svcCtx.ReadConn.QueryRowCtx(queryCtx, &dest,
+			`SELECT a.user_id, a.auth_type, a.identifier FROM t_user_auth a JOIN t_user u ON u.id = a.user_id
+			WHERE a.auth_type = ? AND a.identifier = ? AND a.verified = 1 AND u.status = ? AND u.deleted_at IS NULL
+			LIMIT 1`, authType, identifier, StatusActive)
+		if err == nil {
+			return &dest, nil
+		}
+		if !errors.Is(err, sqlx.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	err := l.svcCtx.ReadConn.QueryRowCtx(queryCtx, &dest,
+		`SELECT a.user_id, a.auth_type, a.identifier FROM t_user u JOIN t_user_auth a ON a.user_id = u.id
+		WHERE u.username = ? AND u.status = ? AND u.deleted_at IS NULL AND a.verified = 1
+		ORDER BY a.auth_type = ? DESC LIMIT 1`, login, StatusActive, AuthTypeEmail)
+	if err != nil {
+		return nil, err
+	}
+	return &dest, nil
+}
@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type ResetPasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetPasswordLogic {
+	return &ResetPasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ResetPassword sets a new password with a token from RequestPasswordReset. Tokens are single use.
+func (l *ResetPasswordLogic) ResetPassword(in *userpb.ResetPasswordRequest) (*userpb.PasswordResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	token := strings.TrimSpace(in.Token)
+	if token == "" {
+		return nil, invalidArgument("token", "token is required")
+	}
+	if in.NewPassword == "" {
+		return nil, invalidArgument("new_password", "new_password is required")
+	}
+
+	// Step 2: Look the token up without spending it, so a password the policy rejects can be
+	// retried with the same link.
+	userID, err := peekResetToken(l.ctx, l.svcCtx, token)
+	if err != nil {
+		if errors.Is(err, errResetTokenInvalid) {
+			return nil, errResetTokenInvalid
+		}
+		l.Errorf("reset password: look up token failed: %v", err)
+		return nil, errInternal
+	}
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var current string
+	if err := l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &current,
+		`SELECT username FROM t_user WHERE id = ? AND status = ? AND deleted_at IS NULL LIMIT 1`,
+		userID, StatusActive); err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			return nil, errResetTokenInvalid
+		}
+		l.Errorf("reset password: query user failed: %v", err)
+		return nil, errInternal
+	}
+	if err := validatePassword(l.svcCtx, in.NewPassword, current); err != nil {
+		return nil, invalidArgument("new_password", err.Error())
+	}
+
+	// Step 3: Consume the token. Only one concurrent request gets past this.
+	if _, err := consumeResetToken(l.ctx, l.svcCtx, token); err != nil {
+		if errors.Is(err, errResetTokenInvalid) {
+			return nil, errResetTokenInvalid
+		}
+		l.Errorf("reset password: consume token failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Step 4: Store the new password and lift any lockout, since the user proved control of
+	// their email or phone.
+	if _, err := setPassword(l.ctx, l.svcCtx, userID, in.NewPassword); err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, errResetTokenInvalid
+		}
+		l.Errorf("reset password: update failed: %v", err)
+		return nil, errInternal
+	}
+	if err := clearLoginFailures(l.ctx, l.svcCtx, accountLockSubject(userID)); err != nil {
+		l.Errorf("reset password: reset lockout failed: %v", err)
+	}
+
+	l.Infof("reset password: success, userId=%d", userID)
+	return &userpb.PasswordResponse{UserId: strconv.FormatInt(userID, 10)}, nil
+}
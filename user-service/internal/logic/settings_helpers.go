@@ -0,0 +1,284 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+const (
+	maxLanguageLength = 10
+	maxTimezoneLength = 50
+)
+
+// defaultNotificationSettings matches the template documented in sql/user-service/init_data.sql.
+const defaultNotificationSettings = `{"push":{"enabled":true,"likes":true,"comments":true,"follows":true,"mentions":true,"messages":true,"system":true},` +
+	`"email":{"enabled":false,"weekly_digest":false,"security_alerts":true},` +
+	`"sms":{"enabled":false,"security_alerts":true},` +
+	`"quiet_hours":{"enabled":false,"start":"22:00","end":"08:00"}}`
+
+type settingKind int
+
+const (
+	settingBool settingKind = iota
+	settingClock
+)
+
+// notificationSchema lists the groups and keys allowed in notification_settings.
+var notificationSchema = map[string]map[string]settingKind{
+	"push": {
+		"enabled": settingBool, "likes": settingBool, "comments": settingBool, "follows": settingBool,
+		"mentions": settingBool, "messages": settingBool, "system": settingBool,
+	},
+	"email": {"enabled": settingBool, "weekly_digest": settingBool, "security_alerts": settingBool},
+	"sms":   {"enabled": settingBool, "security_alerts": settingBool},
+	"quiet_hours": {
+		"enabled": settingBool, "start": settingClock, "end": settingClock,
+	},
+}
+
+var (
+	languageRegex = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z]{2,4})?$`)
+	clockRegex    = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+	themes        = map[string]bool{"auto": true, "light": true, "dark": true}
+)
+
+const settingsColumns = `user_id, privacy_level, allow_stranger_msg, show_online_status, show_likes,
+	show_following, show_followers, notification_settings, language, timezone, theme, updated_at`
+
+type settingsRecord struct {
+	UserID               int64          `db:"user_id"`
+	PrivacyLevel         int32          `db:"privacy_level"`
+	AllowStrangerMsg     int            `db:"allow_stranger_msg"`
+	ShowOnlineStatus     int            `db:"show_online_status"`
+	ShowLikes            int            `db:"show_likes"`
+	ShowFollowing        int            `db:"show_following"`
+	ShowFollowers        int            `db:"show_followers"`
+	NotificationSettings sql.NullString `db:"notification_settings"`
+	Language             sql.NullString `db:"language"`
+	Timezone             sql.NullString `db:"timezone"`
+	Theme                sql.NullString `db:"theme"`
+	UpdatedAt            sql.NullTime   `db:"updated_at"`
+}
+
+func (r *settingsRecord) toProto() *userpb.UserSettings {
+	notifications := nullString(r.NotificationSettings)
+	if notifications == "" {
+		notifications = defaultNotificationSettings
+	}
+	return &userpb.UserSettings{
+		PrivacyLevel:         &r.PrivacyLevel,
+		AllowStrangerMsg:     boolPtr(r.AllowStrangerMsg == 1),
+		ShowOnlineStatus:     boolPtr(r.ShowOnlineStatus == 1),
+		ShowLikes:            boolPtr(r.ShowLikes == 1),
+		ShowFollowing:        boolPtr(r.ShowFollowing == 1),
+		ShowFollowers:        boolPtr(r.ShowFollowers == 1),
+		NotificationSettings: notifications,
+		Language:             stringPtr(nullString(r.Language)),
+		Timezone:             stringPtr(nullString(r.Timezone)),
+		Theme:                stringPtr(nullString(r.Theme)),
+		UpdatedAt:            formatTime(r.UpdatedAt),
+	}
+}
+
+// settingsUpdate collects the column assignments for an UpdateUserSettings call.
+type settingsUpdate struct {
+	columns []string
+	args    []any
+	// notifications is the validated notification_settings patch, nil when absent.
+	notifications map[string]map[string]any
+}
+
+func (u *settingsUpdate) set(column string, value any) {
+	u.columns = append(u.columns, column+" = ?")
+	u.args = append(u.args, value)
+}
+
+func (u *settingsUpdate) empty() bool {
+	return len(u.columns) == 0 && u.notifications == nil
+}
+
+// buildSettingsUpdate validates every field present in s.
+func buildSettingsUpdate(s *userpb.UserSettings) (*settingsUpdate, error) {
+	u := &settingsUpdate{}
+	if s.PrivacyLevel != nil {
+		level := *s.PrivacyLevel
+		if level != PrivacyPrivate && level != PrivacyPublic && level != PrivacyFollowers {
+			return nil, invalidArgument("settings.privacy_level", "privacy_level must be 0, 1 or 2")
+		}
+		u.set("privacy_level", level)
+	}
+	for _, flag := range []struct {
+		column string
+		value  *bool
+	}{
+		{"allow_stranger_msg", s.AllowStrangerMsg},
+		{"show_online_status", s.ShowOnlineStatus},
+		{"show_likes", s.ShowLikes},
+		{"show_following", s.ShowFollowing},
+		{"show_followers", s.ShowFollowers},
+	} {
+		if flag.value != nil {
+			u.set(flag.column, boolToInt(*flag.value))
+		}
+	}
+	if s.Language != nil {
+		language := strings.TrimSpace(*s.Language)
+		if len(language) > maxLanguageLength || !languageRegex.MatchString(language) {
+			return nil, invalidArgument("settings.language", "invalid language tag")
+		}
+		u.set("language", language)
+	}
+	if s.Timezone != nil {
+		timezone := strings.TrimSpace(*s.Timezone)
+		if timezone == "" || len(timezone) > maxTimezoneLength {
+			return nil, invalidArgument("settings.timezone", "invalid timezone")
+		}
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return nil, invalidArgument("settings.timezone", "unknown timezone")
+		}
+		u.set("timezone", timezone)
+	}
+	if s.Theme != nil {
+		theme := strings.TrimSpace(*s.Theme)
+		if !themes[theme] {
+			return nil, invalidArgument("settings.theme", "theme must be auto, light or dark")
+		}
+		u.set("theme", theme)
+	}
+	if raw := strings.TrimSpace(s.NotificationSettings); raw != "" {
+		patch, err := parseNotificationPatch(raw)
+		if err != nil {
+			return nil, invalidArgument("settings.notification_settings", err.Error())
+		}
+		u.notifications = patch
+	}
+	return u, nil
+}
+
+// parseNotificationPatch checks raw against notificationSchema. Null values are dropped.
+func parseNotificationPatch(raw string) (map[string]map[string]any, error) {
+	var groups map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &groups); err != nil {
+		return nil, fmt.Errorf("notification_settings must be a JSON object")
+	}
+	patch := make(map[string]map[string]any, len(groups))
+	for group, body := range groups {
+		keys, ok := notificationSchema[group]
+		if !ok {
+			return nil, fmt.Errorf("unknown notification group %q", group)
+		}
+		if isJSONNull(body) {
+			continue
+		}
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(body, &values); err != nil {
+			return nil, fmt.Errorf("%s must be an object", group)
+		}
+		for key, value := range values {
+			kind, ok := keys[key]
+			if !ok {
+				return nil, fmt.Errorf("unknown notification setting %s.%s", group, key)
+			}
+			if isJSONNull(value) {
+				continue
+			}
+			parsed, err := parseSettingValue(kind, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s %v", group, key, err)
+			}
+			if patch[group] == nil {
+				patch[group] = make(map[string]any)
+			}
+			patch[group][key] = parsed
+		}
+	}
+	return patch, nil
+}
+
+func parseSettingValue(kind settingKind, value json.RawMessage) (any, error) {
+	switch kind {
+	case settingClock:
+		var clock string
+		if err := json.Unmarshal(value, &clock); err != nil || !clockRegex.MatchString(clock) {
+			return nil, fmt.Errorf("must be a HH:MM time")
+		}
+		return clock, nil
+	default:
+		var flag bool
+		if err := json.Unmarshal(value, &flag); err != nil {
+			return nil, fmt.Errorf("must be a boolean")
+		}
+		return flag, nil
+	}
+}
+
+// mergeNotificationSettings applies patch on top of the stored JSON, which falls back to the
+// defaults when missing or unreadable.
+func mergeNotificationSettings(stored string, patch map[string]map[string]any) (string, error) {
+	current := make(map[string]map[string]any)
+	if stored == "" || json.Unmarshal([]byte(stored), &current) != nil {
+		current = make(map[string]map[string]any)
+		if err := json.Unmarshal([]byte(defaultNotificationSettings), &current); err != nil {
+			return "", err
+		}
+	}
+	for group, values := range patch {
+		if current[group] == nil {
+			current[group] = make(map[string]any, len(values))
+		}
+		for key, value := range values {
+			current[group][key] = value
+		}
+	}
+	merged, err := json.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
+
+// ensureSettingsRow creates the default settings row for a live user.
+// It returns errUserNotFound when the user does not exist.
+func ensureSettingsRow(ctx context.Context, session sqlx.Session, userID int64) error {
+	var exists int64
+	if err := session.QueryRowCtx(ctx, &exists,
+		`SELECT COUNT(1) FROM t_user WHERE id = ? AND deleted_at IS NULL`, userID); err != nil {
+		return err
+	}
+	if exists == 0 {
+		return errUserNotFound
+	}
+	_, err := session.ExecCtx(ctx,
+		`INSERT IGNORE INTO t_user_settings (user_id, notification_settings) VALUES (?, ?)`,
+		userID, defaultNotificationSettings)
+	return err
+}
+
+func isJSONNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
@@ -2,17 +2,12 @@ package logic
 
 import (
 	"context"
-	"errors"
-	"strconv"
 	"strings"
 
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
 	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
 
 	"github.com/zeromicro/go-zero/core/logx"
-	"github.com/zeromicro/go-zero/core/stores/sqlx"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type SetUserAvatarLogic struct {
@@ -29,54 +24,31 @@ func NewSetUserAvatarLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Set
 	}
 }
 
+// SetUserAvatar is SetUserMedia for the avatar, or for in.Slot when set.
 func (l *SetUserAvatarLogic) SetUserAvatar(in *userpb.UserAvatarRequest) (*userpb.UserAvatarResponse, error) {
 	if in == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
-	}
-	userID := strings.TrimSpace(in.UserId)
-	if userID == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
-	}
-	parsedID, err := strconv.ParseInt(userID, 10, 64)
-	if err != nil {
-		l.Infof("set user avatar: invalid user_id format: %s", userID)
-		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
-	}
-
-	var exists int64
-	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
-	defer cancel()
-	err = l.svcCtx.ReadConn.QueryRowCtx(queryCtx, &exists, `
-SELECT 1
-FROM t_user_profile
-WHERE user_id = ?
-LIMIT 1`, parsedID)
-	if err != nil {
-		if errors.Is(err, sqlx.ErrNotFound) {
-			l.Infof("set user avatar: not found, userId=%s", userID)
-			return nil, status.Error(codes.NotFound, "user not found")
-		}
-		l.Errorf("set user avatar: query failed: %v", err)
-		return nil, status.Error(codes.Internal, "internal error")
-	}
-
-	objectKey, err := buildAvatarObjectKey(userID)
+		return nil, invalidArgument("request", "request is required")
+	}
+	resp, err := NewSetUserMediaLogic(l.ctx, l.svcCtx).SetUserMedia(&userpb.UserMediaRequest{
+		UserId:      in.UserId,
+		Slot:        avatarRequestSlot(in),
+		ContentType: in.ContentType,
+		Size:        in.Size,
+	})
 	if err != nil {
-		l.Errorf("set user avatar: generate object key failed: %v", err)
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, err
 	}
+	return &userpb.UserAvatarResponse{
+		AvatarUrl:  resp.UploadUrl,
+		ObjectKey:  resp.ObjectKey,
+		FormFields: resp.FormFields,
+	}, nil
+}
 
-	if l.svcCtx.OSSClient == nil {
-		l.Errorf("set user avatar: oss client not initialized")
-		return nil, status.Error(codes.Internal, "internal error")
+// avatarRequestSlot is the slot an avatar request works on: the avatar unless it names another.
+func avatarRequestSlot(in *userpb.UserAvatarRequest) string {
+	if slot := strings.TrimSpace(in.Slot); slot != "" {
+		return slot
 	}
-	ossCtx, cancel := context.WithTimeout(l.ctx, ossOpTimeout)
-	defer cancel()
-	presign, err := l.svcCtx.OSSClient.PresignPut(ossCtx, objectKey, avatarUploadExpiry, "image/jpeg")
-	if err != nil {
-		l.Errorf("set user avatar: presign put failed: %v", err)
-		return nil, status.Error(codes.Internal, "internal error")
-	}
-
-	return &userpb.UserAvatarResponse{AvatarUrl: presign.URL}, nil
+	return slotAvatar.name
 }
@@ -10,15 +10,15 @@ import (
 	"time"
 
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
-	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
 	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
+// clearBackgroundImage is the background_image value that removes the user's background.
+const clearBackgroundImage = "none"
+
 type SetUserDataLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -35,21 +35,21 @@ func NewSetUserDataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUs
 
 func (l *SetUserDataLogic) SetUserData(in *userpb.UserDataRequest) (*userpb.UserDataResponse, error) {
 	if in == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
+		return nil, invalidArgument("request", "request is required")
 	}
 	userID := strings.TrimSpace(in.UserId)
 	if userID == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, invalidArgument("user_id", "user_id is required")
 	}
 	parsedID, err := strconv.ParseInt(userID, 10, 64)
 	if err != nil {
 		l.Infof("set user data: invalid user_id format: %s", userID)
-		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+		return nil, invalidArgument("user_id", "invalid user_id format")
 	}
 
 	info := in.GetUserInfo()
 	if info == nil {
-		return nil, status.Error(codes.InvalidArgument, "user_info is required")
+		return nil, invalidArgument("user_info", "user_info is required")
 	}
 
 	updates := make([]string, 0, 8)
@@ -59,26 +59,34 @@ func (l *SetUserDataLogic) SetUserData(in *userpb.UserDataRequest) (*userpb.User
 		updates = append(updates, "nickname = ?")
 		args = append(args, nickname)
 	}
+	// Uploaded images are attached by ConfirmUserMedia, which checks the object belongs to the
+	// user and re-encodes it. Here a slot can only be reset: the avatar to the shared default, the
+	// background cleared. The image a reset replaces is deleted once the update commits.
+	resets := make([]*mediaSlot, 0, len(mediaSlots))
 	if avatar := strings.TrimSpace(info.Avatar); avatar != "" {
-		if !isHTTPURL(avatar) {
-			if err := util.ValidateObjectName(avatar); err != nil {
-				l.Infof("set user data: invalid avatar object key: %v", err)
-				return nil, status.Error(codes.InvalidArgument, "invalid avatar object key")
-			}
+		if avatar != defaultAvatarKey {
+			l.Infof("set user data: avatar value not accepted, userId=%s", userID)
+			return nil, invalidArgument("user_info.avatar", "upload avatars through SetUserMedia and ConfirmUserMedia")
+		}
+		// An upload still being processed would replace this avatar once done; withdraw it.
+		if err := forgetConfirmedMedia(l.ctx, l.svcCtx, slotAvatar, parsedID); err != nil {
+			l.Errorf("set user data: withdraw queued avatar failed: %v", err)
+			return nil, errInternal
 		}
 		updates = append(updates, "avatar = ?")
 		args = append(args, avatar)
+		resets = append(resets, slotAvatar)
 	}
 	if info.Gender != 0 {
 		if info.Gender < 0 || info.Gender > 2 {
-			return nil, status.Error(codes.InvalidArgument, "invalid gender")
+			return nil, invalidArgument("user_info.gender", "invalid gender")
 		}
 		updates = append(updates, "gender = ?")
 		args = append(args, info.Gender)
 	}
 	if birthday := strings.TrimSpace(info.Birthday); birthday != "" {
 		if _, err := time.Parse("2006-01-02", birthday); err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid birthday format")
+			return nil, invalidArgument("user_info.birthday", "invalid birthday format")
 		}
 		updates = append(updates, "birthday = ?")
 		args = append(args, birthday)
@@ -88,8 +96,17 @@ func (l *SetUserDataLogic) SetUserData(in *userpb.UserDataRequest) (*userpb.User
 		args = append(args, bio)
 	}
 	if backgroundImage := strings.TrimSpace(info.BackgroundImage); backgroundImage != "" {
-		updates = append(updates, "background_image = ?")
-		args = append(args, backgroundImage)
+		if backgroundImage != clearBackgroundImage {
+			l.Infof("set user data: background value not accepted, userId=%s", userID)
+			return nil, invalidArgument("user_info.background_image", "upload backgrounds through SetUserMedia and ConfirmUserMedia")
+		}
+		// An upload still being processed would replace this background once done; withdraw it.
+		if err := forgetConfirmedMedia(l.ctx, l.svcCtx, slotBackground, parsedID); err != nil {
+			l.Errorf("set user data: withdraw queued background failed: %v", err)
+			return nil, errInternal
+		}
+		updates = append(updates, "background_image = NULL")
+		resets = append(resets, slotBackground)
 	}
 	if country := strings.TrimSpace(info.Country); country != "" {
 		updates = append(updates, "country = ?")
@@ -113,74 +130,80 @@ func (l *SetUserDataLogic) SetUserData(in *userpb.UserDataRequest) (*userpb.User
 	}
 	if info.GraduationYear != 0 {
 		if info.GraduationYear < 0 {
-			return nil, status.Error(codes.InvalidArgument, "invalid graduation year")
+			return nil, invalidArgument("user_info.graduation_year", "invalid graduation year")
 		}
 		updates = append(updates, "graduation_year = ?")
 		args = append(args, info.GraduationYear)
 	}
 
 	if len(updates) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+		return nil, invalidArgument("user_info", "no fields to update")
 	}
 
-	query := fmt.Sprintf("UPDATE t_user_profile SET %s WHERE user_id = ?", strings.Join(updates, ", "))
-	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
-	defer cancel()
-	if _, err := l.svcCtx.WriteConn.ExecCtx(execCtx, query, append(args, parsedID)...); err != nil {
-		l.Errorf("set user data: update failed: %v", err)
-		return nil, status.Error(codes.Internal, "internal error")
-	}
-
-	var record struct {
-		UserID          int64          `db:"user_id"`
-		Nickname        sql.NullString `db:"nickname"`
-		Avatar          sql.NullString `db:"avatar"`
-		Gender          sql.NullInt64  `db:"gender"`
-		Birthday        sql.NullTime   `db:"birthday"`
-		Bio             sql.NullString `db:"bio"`
-		BackgroundImage sql.NullString `db:"background_image"`
-		Country         sql.NullString `db:"country"`
-		Province        sql.NullString `db:"province"`
-		City            sql.NullString `db:"city"`
-		School          sql.NullString `db:"school"`
-		Major           sql.NullString `db:"major"`
-		GraduationYear  sql.NullInt64  `db:"graduation_year"`
-		CreatedAt       sql.NullTime   `db:"created_at"`
-		UpdatedAt       sql.NullTime   `db:"updated_at"`
-	}
-
-	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
-	defer cancel()
-	err = l.svcCtx.WriteConn.QueryRowCtx(queryCtx, &record, `
-SELECT user_id, nickname, avatar, gender, birthday, bio, background_image, country, province, city,
-       school, major, graduation_year, created_at, updated_at
-FROM t_user_profile
-WHERE user_id = ?
-LIMIT 1`, parsedID)
+	replaced, err := l.updateProfile(parsedID, updates, args, resets)
 	if err != nil {
 		if errors.Is(err, sqlx.ErrNotFound) {
 			l.Infof("set user data: not found, userId=%s", userID)
-			return nil, status.Error(codes.NotFound, "user not found")
+			return nil, errUserNotFound
+		}
+		l.Errorf("set user data: update failed: %v", err)
+		return nil, errInternal
+	}
+	// The row changed; route this user's reads to the primary for a while and drop the cached
+	// copy before reading it back.
+	markWritten(l.ctx, l.svcCtx, parsedID)
+	invalidateProfile(l.ctx, l.svcCtx, parsedID)
+
+	// Shared defaults live outside the user's directory and are never touched.
+	for slot, previous := range replaced {
+		if strings.HasPrefix(previous, slot.userPrefix(userID)) {
+			if err := deleteMediaObjects(l.ctx, l.svcCtx, previous); err != nil {
+				l.Errorf("set user data: delete previous %s failed, key=%s: %v", slot.name, previous, err)
+			}
+		}
+	}
+
+	var record profileRecord
+	if err := queryProfile(l.ctx, l.svcCtx.WriteConn, parsedID, &record); err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			l.Infof("set user data: not found, userId=%s", userID)
+			return nil, errUserNotFound
 		}
 		l.Errorf("set user data: query failed: %v", err)
-		return nil, status.Error(codes.Internal, "internal error")
-	}
-
-	return &userpb.UserDataResponse{
-		UserId:          strconv.FormatInt(record.UserID, 10),
-		Nickname:        nullString(record.Nickname),
-		Avatar:          nullString(record.Avatar),
-		Gender:          nullInt32(record.Gender),
-		Birthday:        formatDate(record.Birthday),
-		Bio:             nullString(record.Bio),
-		BackgroundImage: nullString(record.BackgroundImage),
-		Country:         nullString(record.Country),
-		Province:        nullString(record.Province),
-		City:            nullString(record.City),
-		School:          nullString(record.School),
-		Major:           nullString(record.Major),
-		GraduationYear:  nullInt32(record.GraduationYear),
-		CreatedAt:       formatTime(record.CreatedAt),
-		UpdatedAt:       formatTime(record.UpdatedAt),
-	}, nil
+		return nil, errInternal
+	}
+	refreshProfile(l.ctx, l.svcCtx, &record)
+
+	return record.toProto(), nil
+}
+
+// updateProfile applies the update and returns the current image of every slot in resets. They
+// are read under the row lock the update takes, so a media upload being applied concurrently is
+// either replaced here or applied after, never lost.
+func (l *SetUserDataLogic) updateProfile(userID int64, updates []string, args []any, resets []*mediaSlot) (map[*mediaSlot]string, error) {
+	query := fmt.Sprintf("UPDATE t_user_profile SET %s WHERE user_id = ?", strings.Join(updates, ", "))
+	args = append(args, userID)
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	if len(resets) == 0 {
+		_, err := l.svcCtx.WriteConn.ExecCtx(execCtx, query, args...)
+		return nil, err
+	}
+
+	replaced := make(map[*mediaSlot]string, len(resets))
+	err := l.svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		for _, slot := range resets {
+			var current struct {
+				Value sql.NullString `db:"value"`
+			}
+			if err := session.QueryRowCtx(ctx, &current,
+				`SELECT `+slot.column+` AS value FROM t_user_profile WHERE user_id = ? FOR UPDATE`, userID); err != nil {
+				return err
+			}
+			replaced[slot] = nullString(current.Value)
+		}
+		_, err := session.ExecCtx(ctx, query, args...)
+		return err
+	})
+	return replaced, err
 }
@@ -0,0 +1,116 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/config"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type SetUserMediaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetUserMediaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUserMediaLogic {
+	return &SetUserMediaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetUserMedia starts an upload to a media slot: it returns a presigned POST form for a new object
+// key and remembers the key until ConfirmUserMedia or mediaPendingExpiry. The form's policy only
+// accepts the declared content type and size, so the storage service rejects anything else.
+func (l *SetUserMediaLogic) SetUserMedia(in *userpb.UserMediaRequest) (*userpb.UserMediaResponse, error) {
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	userID := strings.TrimSpace(in.UserId)
+	if userID == "" {
+		return nil, invalidArgument("user_id", "user_id is required")
+	}
+	parsedID, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		l.Infof("set user media: invalid user_id format: %s", userID)
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	if strings.TrimSpace(in.Slot) == "" {
+		return nil, invalidArgument("slot", "slot is required")
+	}
+	slot, err := mediaSlotByName(in.Slot)
+	if err != nil {
+		return nil, err
+	}
+	limits := slot.limits(l.svcCtx.Config)
+	contentType := strings.ToLower(strings.TrimSpace(in.ContentType))
+	extension, ok := config.ImageExtensions[contentType]
+	if !ok || !slices.Contains(limits.ContentTypes, contentType) {
+		return nil, invalidArgument("content_type", slot.name+" must be one of "+strings.Join(limits.ContentTypes, ", "))
+	}
+	if in.Size <= 0 || in.Size > limits.MaxBytes {
+		return nil, invalidArgument("size", slot.name+" size must be 1-"+strconv.FormatInt(limits.MaxBytes, 10)+" bytes")
+	}
+
+	var exists int64
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	err = readConnFor(l.ctx, l.svcCtx, parsedID).QueryRowCtx(queryCtx, &exists, `
+SELECT 1
+FROM t_user_profile
+WHERE user_id = ?
+LIMIT 1`, parsedID)
+	if err != nil {
+		if errors.Is(err, sqlx.ErrNotFound) {
+			l.Infof("set user media: not found, userId=%s", userID)
+			return nil, errUserNotFound
+		}
+		l.Errorf("set user media: query failed: %v", err)
+		return nil, errInternal
+	}
+
+	objectKey, err := slot.newObjectKey(userID, extension)
+	if err != nil {
+		l.Errorf("set user media: generate object key failed: %v", err)
+		return nil, errInternal
+	}
+
+	if l.svcCtx.ObjectStore == nil {
+		l.Errorf("set user media: object store not initialized")
+		return nil, errInternal
+	}
+	storeCtx, cancel := context.WithTimeout(l.ctx, storeOpTimeout)
+	defer cancel()
+	presign, err := l.svcCtx.ObjectStore.PresignPost(storeCtx, objectKey, mediaUploadExpiry, util.PostPolicy{
+		ContentType: contentType,
+		MinBytes:    in.Size,
+		MaxBytes:    in.Size,
+	})
+	if err != nil {
+		l.Errorf("set user media: presign post failed: %v", err)
+		return nil, errInternal
+	}
+
+	// The profile only changes once ConfirmUserMedia finds the uploaded object.
+	if err := slot.savePending(l.ctx, l.svcCtx, userID, objectKey); err != nil {
+		l.Errorf("set user media: save pending upload failed: %v", err)
+		return nil, errInternal
+	}
+
+	return &userpb.UserMediaResponse{
+		UploadUrl:  presign.URL,
+		ObjectKey:  objectKey,
+		FormFields: presign.Fields,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type UnbindIdentifierLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnbindIdentifierLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnbindIdentifierLogic {
+	return &UnbindIdentifierLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnbindIdentifier removes the user's phone or email of the given auth_type.
+func (l *UnbindIdentifierLogic) UnbindIdentifier(in *userpb.IdentifierRequest) (*userpb.IdentifierResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	if in.AuthType != AuthTypePhone && in.AuthType != AuthTypeEmail {
+		return nil, invalidArgument("auth_type", "unsupported auth_type")
+	}
+
+	// Step 2: Delete every binding of this type, verified or pending. An account without a
+	// password of its own must keep a way to sign in.
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	err = l.svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		if err := lockAccount(ctx, session, parsedID); err != nil {
+			return err
+		}
+		result, err := session.ExecCtx(ctx,
+			`DELETE FROM t_user_auth WHERE user_id = ? AND auth_type = ?`, parsedID, in.AuthType)
+		if err != nil {
+			return err
+		}
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return errIdentifierNotFound
+		}
+		return ensureSignInMethod(ctx, session, parsedID)
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, sqlx.ErrNotFound):
+		return nil, errUserNotFound
+	case errors.Is(err, errIdentifierNotFound), errors.Is(err, errLastSignInMethod):
+		return nil, err
+	default:
+		l.Errorf("unbind identifier: delete binding failed: %v", err)
+		return nil, errInternal
+	}
+
+	l.Infof("unbind identifier: success, userId=%d authType=%d", parsedID, in.AuthType)
+	return &userpb.IdentifierResponse{AuthType: in.AuthType}, nil
+}
@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type UnbindOAuthLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnbindOAuthLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnbindOAuthLogic {
+	return &UnbindOAuthLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnbindOAuth removes the user's binding for identity.platform, unless it is their last way to sign in.
+func (l *UnbindOAuthLogic) UnbindOAuth(in *userpb.OAuthRequest) (*userpb.OAuthBindingsResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil || in.Identity == nil {
+		return nil, invalidArgument("identity", "identity is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	platform := strings.ToLower(strings.TrimSpace(in.Identity.Platform))
+	if !platformRegex.MatchString(platform) {
+		return nil, invalidArgument("identity.platform", "invalid platform")
+	}
+
+	// Step 2: Delete the user's binding on this platform, keeping at least one way to sign in.
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	err = l.svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		if err := lockAccount(ctx, session, parsedID); err != nil {
+			return err
+		}
+		result, err := session.ExecCtx(ctx,
+			`DELETE FROM t_user_oauth WHERE user_id = ? AND platform = ?`, parsedID, platform)
+		if err != nil {
+			return err
+		}
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return errOAuthNotBound
+		}
+		return ensureSignInMethod(ctx, session, parsedID)
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, sqlx.ErrNotFound):
+		return nil, errUserNotFound
+	case errors.Is(err, errOAuthNotBound), errors.Is(err, errLastSignInMethod):
+		l.Infof("unbind oauth: rejected, userId=%d platform=%s: %v", parsedID, platform, err)
+		return nil, err
+	default:
+		l.Errorf("unbind oauth: delete binding failed: %v", err)
+		return nil, errInternal
+	}
+	markWritten(l.ctx, l.svcCtx, parsedID)
+
+	// Step 3: Return the remaining bindings.
+	resp, err := listOAuthBindings(l.ctx, l.svcCtx.WriteConn, parsedID)
+	if err != nil {
+		l.Errorf("unbind oauth: list bindings failed: %v", err)
+		return nil, errInternal
+	}
+
+	l.Infof("unbind oauth: success, userId=%d platform=%s", parsedID, platform)
+	return resp, nil
+}
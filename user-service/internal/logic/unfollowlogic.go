@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type UnfollowLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnfollowLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnfollowLogic {
+	return &UnfollowLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Unfollow removes the relationship. Unfollowing a user not followed is a no-op.
+func (l *UnfollowLogic) Unfollow(in *userpb.FollowRequest) (*userpb.FollowResponse, error) {
+	// Step 1: Validate request parameters.
+	userID, targetID, err := parseFollowPair(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Delete the relationship and decrement both counters in one transaction.
+	// Counters only move when a row was actually removed, so repeated calls are harmless.
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	err = l.svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		result, err := session.ExecCtx(ctx,
+			`DELETE FROM t_user_follow WHERE follower_id = ? AND followee_id = ?`, userID, targetID)
+		if err != nil {
+			return err
+		}
+		if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+			return err
+		}
+		if _, err := session.ExecCtx(ctx,
+			`UPDATE t_user_stats SET following_count = IF(following_count > 0, following_count - 1, 0)
+			WHERE user_id = ?`, userID); err != nil {
+			return err
+		}
+		_, err = session.ExecCtx(ctx,
+			`UPDATE t_user_stats SET follower_count = IF(follower_count > 0, follower_count - 1, 0)
+			WHERE user_id = ?`, targetID)
+		return err
+	})
+	if err != nil {
+		l.Errorf("unfollow: delete failed: %v", err)
+		return nil, errInternal
+	}
+
+	// Both users' lists and counters changed.
+	markWritten(l.ctx, l.svcCtx, userID, targetID)
+	l.Infof("unfollow: success, userId=%d targetUserId=%d", userID, targetID)
+	return &userpb.FollowResponse{Following: false}, nil
+}
@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type UpdateUserSettingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateUserSettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateUserSettingsLogic {
+	return &UpdateUserSettingsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdateUserSettings changes only the fields present in the request.
+func (l *UpdateUserSettingsLogic) UpdateUserSettings(in *userpb.UserSettingsRequest) (*userpb.UserSettingsResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	parsedID, err := strconv.ParseInt(strings.TrimSpace(in.UserId), 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	if in.Settings == nil {
+		return nil, invalidArgument("settings", "settings is required")
+	}
+	update, err := buildSettingsUpdate(in.Settings)
+	if err != nil {
+		return nil, err
+	}
+	if update.empty() {
+		return nil, invalidArgument("settings", "no fields to update")
+	}
+
+	// Step 2: Apply the update in one transaction, locking the row so concurrent
+	// notification patches merge instead of overwriting each other.
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	var record settingsRecord
+	err = l.svcCtx.WriteConn.TransactCtx(execCtx, func(ctx context.Context, session sqlx.Session) error {
+		if err := ensureSettingsRow(ctx, session, parsedID); err != nil {
+			return err
+		}
+		if update.notifications != nil {
+			var stored sql.NullString
+			if err := session.QueryRowCtx(ctx, &stored,
+				`SELECT notification_settings FROM t_user_settings WHERE user_id = ? FOR UPDATE`, parsedID); err != nil {
+				return err
+			}
+			merged, err := mergeNotificationSettings(nullString(stored), update.notifications)
+			if err != nil {
+				return err
+			}
+			update.set("notification_settings", merged)
+		}
+		query := fmt.Sprintf("UPDATE t_user_settings SET %s WHERE user_id = ?", strings.Join(update.columns, ", "))
+		if _, err := session.ExecCtx(ctx, query, append(update.args, parsedID)...); err != nil {
+			return err
+		}
+		return session.QueryRowCtx(ctx, &record,
+			`SELECT `+settingsColumns+` FROM t_user_settings WHERE user_id = ? LIMIT 1`, parsedID)
+	})
+	if err != nil {
+		if errors.Is(err, errUserNotFound) {
+			return nil, errUserNotFound
+		}
+		l.Errorf("update user settings: update failed: %v", err)
+		return nil, errInternal
+	}
+
+	markWritten(l.ctx, l.svcCtx, parsedID)
+	l.Infof("update user settings: success, userId=%d", parsedID)
+	return &userpb.UserSettingsResponse{Settings: record.toProto()}, nil
+}
@@ -1,43 +1,131 @@
 package logic
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/config"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+
+	"golang.org/x/text/unicode/norm"
 )
 
-// Input validation constraints.
+// Hard limits that hold whatever the configured policies say.
 const (
-	MinUsernameLength = 3
 	MaxUsernameLength = 32
-	MinPasswordLength = 8
 	MaxPasswordLength = 72 // bcrypt max input length
 )
 
-func validateUsername(username string) error {
-	if len(username) < MinUsernameLength || len(username) > MaxUsernameLength {
-		return fmt.Errorf("username length must be %d-%d characters", MinUsernameLength, MaxUsernameLength)
+// confusableScripts are scripts with many letters that look alike; a username may use only one.
+var confusableScripts = []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic, unicode.Greek}
+
+// normalizeLogin maps a login name to the form usernames are stored in, so compatibility
+// variants neither miss the account nor get a lockout counter of their own.
+func normalizeLogin(login string) string {
+	return norm.NFKC.String(strings.TrimSpace(login))
+}
+
+// validateUsername checks username against UsernamePolicy and returns the NFKC-normalized form
+// that should be stored.
+func validateUsername(svcCtx *svc.ServiceContext, username string) (string, error) {
+	policy := svcCtx.Config.UsernamePolicy
+	username = norm.NFKC.String(username)
+
+	length := utf8.RuneCountInString(username)
+	if length < policy.MinLength || length > policy.MaxLength {
+		return "", fmt.Errorf("username length must be %d-%d characters", policy.MinLength, policy.MaxLength)
 	}
-	return nil
+
+	var script *unicode.RangeTable
+	for _, c := range username {
+		switch {
+		case c == '_' || c == '.' || c == '-':
+			continue
+		case policy.Charset == config.CharsetASCII:
+			if c > unicode.MaxASCII || !(unicode.IsLetter(c) || unicode.IsDigit(c)) {
+				return "", errors.New("username may only contain letters, digits, '_', '.' and '-'")
+			}
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c) && !unicode.Is(unicode.Mn, c):
+			return "", errors.New("username may only contain letters, digits, '_', '.' and '-'")
+		}
+		for _, table := range confusableScripts {
+			if !unicode.Is(table, c) {
+				continue
+			}
+			if script != nil && script != table {
+				return "", errors.New("username may not mix letters from different alphabets")
+			}
+			script = table
+		}
+	}
+
+	lower := strings.ToLower(username)
+	if strings.HasPrefix(lower, purgedUsernamePrefix) {
+		return "", errors.New("username is reserved")
+	}
+	for _, reserved := range policy.Reserved {
+		if lower == strings.ToLower(norm.NFKC.String(reserved)) {
+			return "", errors.New("username is reserved")
+		}
+	}
+	return username, nil
 }
 
-func validatePassword(password string) error {
-	if len(password) < MinPasswordLength {
-		return fmt.Errorf("password must be at least %d characters", MinPasswordLength)
+// validatePassword checks a new password against PasswordPolicy. username may be empty when the
+// account is not known yet.
+func validatePassword(svcCtx *svc.ServiceContext, password, username string) error {
+	policy := svcCtx.Config.PasswordPolicy
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		return fmt.Errorf("password must be at least %d characters", policy.MinLength)
 	}
 	if len(password) > MaxPasswordLength {
-		return fmt.Errorf("password must be at most %d characters", MaxPasswordLength)
+		return fmt.Errorf("password must be at most %d bytes", MaxPasswordLength)
 	}
-	var hasLetter, hasDigit bool
+
+	present := make(map[string]bool)
 	for _, c := range password {
 		switch {
+		case unicode.IsUpper(c):
+			present[config.ClassUpper] = true
+			present[config.ClassLetter] = true
+		case unicode.IsLower(c):
+			present[config.ClassLower] = true
+			present[config.ClassLetter] = true
 		case unicode.IsLetter(c):
-			hasLetter = true
+			present[config.ClassLetter] = true
 		case unicode.IsDigit(c):
-			hasDigit = true
+			present[config.ClassDigit] = true
+		case !unicode.IsSpace(c):
+			present[config.ClassSymbol] = true
+		}
+	}
+	var missing []string
+	for _, class := range policy.RequiredClasses {
+		if !present[class] {
+			missing = append(missing, passwordClassNames[class])
 		}
 	}
-	if !hasLetter || !hasDigit {
-		return fmt.Errorf("password must contain both letters and digits")
+	if len(missing) > 0 {
+		return fmt.Errorf("password must contain %s", strings.Join(missing, ", "))
+	}
+
+	if policy.DisallowUsername && username != "" &&
+		strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		return errors.New("password must not contain the username")
+	}
+	if svcCtx.BreachedPasswords.Contains(password) {
+		return errors.New("password appears in a known data breach, choose another one")
 	}
 	return nil
 }
+
+var passwordClassNames = map[string]string{
+	config.ClassLetter: "a letter",
+	config.ClassUpper:  "an upper-case letter",
+	config.ClassLower:  "a lower-case letter",
+	config.ClassDigit:  "a digit",
+	config.ClassSymbol: "a symbol",
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyIdentifierLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyIdentifierLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyIdentifierLogic {
+	return &VerifyIdentifierLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// VerifyIdentifier confirms a bound phone or email with the code, enabling it for login.
+func (l *VerifyIdentifierLogic) VerifyIdentifier(in *userpb.IdentifierRequest) (*userpb.IdentifierResponse, error) {
+	// Step 1: Validate request parameters.
+	if in == nil {
+		return nil, invalidArgument("request", "request is required")
+	}
+	userID := strings.TrimSpace(in.UserId)
+	parsedID, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return nil, invalidArgument("user_id", "invalid user_id format")
+	}
+	identifier, err := normalizeIdentifier(in.AuthType, in.Identifier)
+	if err != nil {
+		return nil, invalidArgument("identifier", err.Error())
+	}
+	code := strings.TrimSpace(in.Code)
+	if code == "" {
+		return nil, invalidArgument("code", "code is required")
+	}
+
+	// Step 2: Check the code stored by BindIdentifier.
+	if err := checkVerifyCode(l.ctx, l.svcCtx, in.AuthType, identifier, userID, code); err != nil {
+		l.Infof("verify identifier: code rejected, userId=%d authType=%d: %v", parsedID, in.AuthType, err)
+		return nil, err
+	}
+
+	// Step 3: Mark the binding as verified.
+	execCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+	result, err := l.svcCtx.WriteConn.ExecCtx(execCtx,
+		`UPDATE t_user_auth SET verified = 1, verified_at = NOW() WHERE user_id = ? AND auth_type = ? AND identifier = ? AND verified = 0`,
+		parsedID, in.AuthType, identifier)
+	if err != nil {
+		if isDuplicateKey(err) {
+			return nil, errIdentifierTaken
+		}
+		l.Errorf("verify identifier: update binding failed: %v", err)
+		return nil, errInternal
+	}
+	if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+		return nil, errIdentifierNotFound
+	}
+
+	l.Infof("verify identifier: success, userId=%d authType=%d", parsedID, in.AuthType)
+	return &userpb.IdentifierResponse{AuthType: in.AuthType, Identifier: identifier, Verified: true}, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
 	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
@@ -30,44 +29,114 @@ func NewVerifyPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Ve
 
 func (l *VerifyPasswordLogic) VerifyPassword(in *userpb.VerifyPasswordRequest) (*userpb.VerifyPasswordResponse, error) {
 	if in == nil {
-		return nil, fmt.Errorf("request is required")
+		return nil, invalidArgument("request", "request is required")
 	}
-	username := strings.TrimSpace(in.Username)
+	username := normalizeLogin(in.Username)
 	password := in.Password
 	if username == "" || password == "" {
-		return &userpb.VerifyPasswordResponse{Code: CodeInvalidParam, Message: "invalid credentials"}, nil
+		return legacyResult(l.ctx, invalidCredentialsResponse(), errInvalidCredentials)
 	}
 
-	var record struct {
-		ID     int64  `db:"id"`
-		Hash   string `db:"password"`
-		Status int    `db:"status"`
+	record, err := l.findAccount(username)
+	if err != nil && !errors.Is(err, sqlx.ErrNotFound) {
+		l.Errorf("verify password: query failed: %v", err)
+		return nil, errInternal
 	}
-	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
-	defer cancel()
-	err := l.svcCtx.ReadConn.QueryRowCtx(queryCtx, &record,
-		`SELECT id, password, status FROM t_user WHERE username = ? AND deleted_at IS NULL LIMIT 1`, username)
+	subject := loginLockSubject(username)
+	if record != nil {
+		subject = accountLockSubject(record.ID)
+	}
+
+	remaining, err := lockRemaining(l.ctx, l.svcCtx, subject)
 	if err != nil {
-		if errors.Is(err, sqlx.ErrNotFound) {
-			l.Infof("verify password: account not found, username=%s", username)
-			return &userpb.VerifyPasswordResponse{Code: CodeInvalidParam, Message: "invalid credentials"}, nil
-		}
-		l.Errorf("verify password: query failed: %v", err)
-		return nil, err
+		l.Errorf("verify password: lockout check failed: %v", err)
+	} else if remaining > 0 {
+		l.Infof("verify password: account locked, username=%s remaining=%s", username, remaining)
+		return legacyResult(l.ctx, &userpb.VerifyPasswordResponse{Code: CodeLocked, Message: "account temporarily locked"},
+			accountLocked(remaining))
+	}
+
+	if record == nil {
+		l.Infof("verify password: account not found, username=%s", username)
+		l.recordFailure(subject)
+		return legacyResult(l.ctx, invalidCredentialsResponse(), errInvalidCredentials)
 	}
-	if record.Status != 1 {
+	if record.Status != StatusActive {
 		l.Infof("verify password: account disabled, username=%s status=%d", username, record.Status)
-		return &userpb.VerifyPasswordResponse{Code: CodeInvalidParam, Message: "invalid credentials"}, nil
+		l.recordFailure(subject)
+		return legacyResult(l.ctx, invalidCredentialsResponse(), errInvalidCredentials)
 	}
 	if bcrypt.CompareHashAndPassword([]byte(record.Hash), []byte(password)) != nil {
 		l.Infof("verify password: incorrect password, username=%s", username)
-		return &userpb.VerifyPasswordResponse{Code: CodeInvalidParam, Message: "invalid credentials"}, nil
+		l.recordFailure(subject)
+		return legacyResult(l.ctx, invalidCredentialsResponse(), errInvalidCredentials)
+	}
+
+	roles, err := queryUserRoles(l.ctx, l.svcCtx, record.ID)
+	if err != nil {
+		l.Errorf("verify password: query roles failed: %v", err)
+		return nil, errInternal
+	}
+
+	if err := clearLoginFailures(l.ctx, l.svcCtx, subject); err != nil {
+		l.Errorf("verify password: reset lockout failed: %v", err)
 	}
 
 	l.Infof("verify password: success, username=%s userId=%d", username, record.ID)
 	return &userpb.VerifyPasswordResponse{
 		Code:   CodeSuccess,
 		UserId: fmt.Sprintf("%d", record.ID),
-		Roles:  []string{},
+		Roles:  roles,
 	}, nil
 }
+
+type accountRecord struct {
+	ID     int64  `db:"id"`
+	Hash   string `db:"password"`
+	Status int    `db:"status"`
+}
+
+// findAccount resolves a login identifier: a verified email or phone from t_user_auth first,
+// then a username. Usernames are not restricted, so a phone-like login may still be a username.
+func (l *VerifyPasswordLogic) findAccount(login string) (*accountRecord, error) {
+	queryCtx, cancel := context.WithTimeout(l.ctx, dbQueryTimeout)
+	defer cancel()
+
+	var record accountRecord
+	if authType, identifier, ok := detectLoginIdentifier(login); ok {
+		err := l.svcCtx.ReadConn.QueryRowCtx(queryCtx, &record,
+			`SELECT u.id, u.password, u.status FROM t_user_auth a JOIN t_user u ON u.id = a.user_id
+			WHERE a.auth_type = ? AND a.identifier = ? AND a.verified = 1 AND u.deleted_at IS NULL LIMIT 1`,
+			authType, identifier)
+		if err == nil {
+			return &record, nil
+		}
+		if !errors.Is(err, sqlx.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	if err := l.svcCtx.ReadConn.QueryRowCtx(queryCtx, &record,
+		`SELECT id, password, status FROM t_user WHERE username = ? AND deleted_at IS NULL LIMIT 1`, login); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// recordFailure counts a failed attempt towards the lockout; redis errors are logged, not returned.
+func (l *VerifyPasswordLogic) recordFailure(subject string) {
+	locked, err := recordLoginFailure(l.ctx, l.svcCtx, subject)
+	if err != nil {
+		l.Errorf("verify password: record failure failed: %v", err)
+		return
+	}
+	if locked > 0 {
+		l.Infof("verify password: account locked, subject=%s duration=%s", subject, locked)
+	}
+}
+
+// invalidCredentialsResponse is the deprecated in-body answer for any failed check,
+// kept identical across causes so callers cannot enumerate usernames.
+func invalidCredentialsResponse() *userpb.VerifyPasswordResponse {
+	return &userpb.VerifyPasswordResponse{Code: CodeInvalidParam, Message: "invalid credentials"}
+}
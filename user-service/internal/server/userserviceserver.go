@@ -46,12 +46,181 @@ func (s *UserServiceServer) SetUserData(ctx context.Context, in *userpb.UserData
 	return l.SetUserData(in)
 }
 
+// GetUserRoles returns the current username and roles of an active account, so tokens are
+func (s *UserServiceServer) GetUserRoles(ctx context.Context, in *userpb.UserRolesRequest) (*userpb.UserRolesResponse, error) {
+	l := logic.NewGetUserRolesLogic(ctx, s.svcCtx)
+	return l.GetUserRoles(in)
+}
+
 func (s *UserServiceServer) GetUserAvatar(ctx context.Context, in *userpb.UserAvatarRequest) (*userpb.UserAvatarResponse, error) {
 	l := logic.NewGetUserAvatarLogic(ctx, s.svcCtx)
 	return l.GetUserAvatar(in)
 }
 
+// SetUserAvatar is SetUserMedia for the avatar, or for the slot named in the request.
 func (s *UserServiceServer) SetUserAvatar(ctx context.Context, in *userpb.UserAvatarRequest) (*userpb.UserAvatarResponse, error) {
 	l := logic.NewSetUserAvatarLogic(ctx, s.svcCtx)
 	return l.SetUserAvatar(in)
 }
+
+// ConfirmUserAvatar is ConfirmUserMedia for the avatar, or for the slot named in the request.
+func (s *UserServiceServer) ConfirmUserAvatar(ctx context.Context, in *userpb.UserAvatarRequest) (*userpb.UserAvatarResponse, error) {
+	l := logic.NewConfirmUserAvatarLogic(ctx, s.svcCtx)
+	return l.ConfirmUserAvatar(in)
+}
+
+// SetUserMedia starts an upload to a media slot and returns the presigned form.
+func (s *UserServiceServer) SetUserMedia(ctx context.Context, in *userpb.UserMediaRequest) (*userpb.UserMediaResponse, error) {
+	l := logic.NewSetUserMediaLogic(ctx, s.svcCtx)
+	return l.SetUserMedia(in)
+}
+
+// ConfirmUserMedia queues the object uploaded through SetUserMedia for processing; the processed
+func (s *UserServiceServer) ConfirmUserMedia(ctx context.Context, in *userpb.UserMediaRequest) (*userpb.UserMediaResponse, error) {
+	l := logic.NewConfirmUserMediaLogic(ctx, s.svcCtx)
+	return l.ConfirmUserMedia(in)
+}
+
+// BindIdentifier attaches an unverified phone or email to a user and sends a verification code.
+func (s *UserServiceServer) BindIdentifier(ctx context.Context, in *userpb.IdentifierRequest) (*userpb.IdentifierResponse, error) {
+	l := logic.NewBindIdentifierLogic(ctx, s.svcCtx)
+	return l.BindIdentifier(in)
+}
+
+// VerifyIdentifier confirms a bound phone or email with the code, enabling it for login.
+func (s *UserServiceServer) VerifyIdentifier(ctx context.Context, in *userpb.IdentifierRequest) (*userpb.IdentifierResponse, error) {
+	l := logic.NewVerifyIdentifierLogic(ctx, s.svcCtx)
+	return l.VerifyIdentifier(in)
+}
+
+// UnbindIdentifier removes the user's phone or email of the given auth_type.
+func (s *UserServiceServer) UnbindIdentifier(ctx context.Context, in *userpb.IdentifierRequest) (*userpb.IdentifierResponse, error) {
+	l := logic.NewUnbindIdentifierLogic(ctx, s.svcCtx)
+	return l.UnbindIdentifier(in)
+}
+
+// OAuthLogin finds the user bound to a third-party identity, creating one on first login.
+func (s *UserServiceServer) OAuthLogin(ctx context.Context, in *userpb.OAuthRequest) (*userpb.OAuthLoginResponse, error) {
+	l := logic.NewOAuthLoginLogic(ctx, s.svcCtx)
+	return l.OAuthLogin(in)
+}
+
+// BindOAuth attaches a third-party identity to an existing user.
+func (s *UserServiceServer) BindOAuth(ctx context.Context, in *userpb.OAuthRequest) (*userpb.OAuthBindingsResponse, error) {
+	l := logic.NewBindOAuthLogic(ctx, s.svcCtx)
+	return l.BindOAuth(in)
+}
+
+// UnbindOAuth removes the user's binding for identity.platform.
+func (s *UserServiceServer) UnbindOAuth(ctx context.Context, in *userpb.OAuthRequest) (*userpb.OAuthBindingsResponse, error) {
+	l := logic.NewUnbindOAuthLogic(ctx, s.svcCtx)
+	return l.UnbindOAuth(in)
+}
+
+// ListOAuthBindings lists the third-party platforms bound to a user.
+func (s *UserServiceServer) ListOAuthBindings(ctx context.Context, in *userpb.OAuthRequest) (*userpb.OAuthBindingsResponse, error) {
+	l := logic.NewListOAuthBindingsLogic(ctx, s.svcCtx)
+	return l.ListOAuthBindings(in)
+}
+
+// GetUserSettings returns the user's settings, creating the row with defaults on first access.
+func (s *UserServiceServer) GetUserSettings(ctx context.Context, in *userpb.UserSettingsRequest) (*userpb.UserSettingsResponse, error) {
+	l := logic.NewGetUserSettingsLogic(ctx, s.svcCtx)
+	return l.GetUserSettings(in)
+}
+
+// UpdateUserSettings changes only the fields present in the request.
+func (s *UserServiceServer) UpdateUserSettings(ctx context.Context, in *userpb.UserSettingsRequest) (*userpb.UserSettingsResponse, error) {
+	l := logic.NewUpdateUserSettingsLogic(ctx, s.svcCtx)
+	return l.UpdateUserSettings(in)
+}
+
+// Follow makes user_id follow target_user_id. Following twice is a no-op.
+func (s *UserServiceServer) Follow(ctx context.Context, in *userpb.FollowRequest) (*userpb.FollowResponse, error) {
+	l := logic.NewFollowLogic(ctx, s.svcCtx)
+	return l.Follow(in)
+}
+
+// Unfollow removes the relationship. Unfollowing a user not followed is a no-op.
+func (s *UserServiceServer) Unfollow(ctx context.Context, in *userpb.FollowRequest) (*userpb.FollowResponse, error) {
+	l := logic.NewUnfollowLogic(ctx, s.svcCtx)
+	return l.Unfollow(in)
+}
+
+// IsFollowing reports the relationship between user_id and target_user_id in both directions.
+func (s *UserServiceServer) IsFollowing(ctx context.Context, in *userpb.FollowRequest) (*userpb.FollowResponse, error) {
+	l := logic.NewIsFollowingLogic(ctx, s.svcCtx)
+	return l.IsFollowing(in)
+}
+
+// ListFollowers lists who follows user_id, newest first.
+func (s *UserServiceServer) ListFollowers(ctx context.Context, in *userpb.FollowListRequest) (*userpb.FollowListResponse, error) {
+	l := logic.NewListFollowersLogic(ctx, s.svcCtx)
+	return l.ListFollowers(in)
+}
+
+// ListFollowing lists who user_id follows, newest first.
+func (s *UserServiceServer) ListFollowing(ctx context.Context, in *userpb.FollowListRequest) (*userpb.FollowListResponse, error) {
+	l := logic.NewListFollowingLogic(ctx, s.svcCtx)
+	return l.ListFollowing(in)
+}
+
+// GetPublicProfile returns what viewer_id may see of user_id's profile under their privacy_level.
+func (s *UserServiceServer) GetPublicProfile(ctx context.Context, in *userpb.PublicProfileRequest) (*userpb.PublicProfileResponse, error) {
+	l := logic.NewGetPublicProfileLogic(ctx, s.svcCtx)
+	return l.GetPublicProfile(in)
+}
+
+// BatchGetUserCards hydrates up to 100 user ids into nickname and avatar cards in one call.
+func (s *UserServiceServer) BatchGetUserCards(ctx context.Context, in *userpb.BatchGetUserCardsRequest) (*userpb.BatchGetUserCardsResponse, error) {
+	l := logic.NewBatchGetUserCardsLogic(ctx, s.svcCtx)
+	return l.BatchGetUserCards(in)
+}
+
+// DeactivateAccount soft-deletes user_id. The account is purged once the grace period ends.
+func (s *UserServiceServer) DeactivateAccount(ctx context.Context, in *userpb.DeactivateAccountRequest) (*userpb.DeactivateAccountResponse, error) {
+	l := logic.NewDeactivateAccountLogic(ctx, s.svcCtx)
+	return l.DeactivateAccount(in)
+}
+
+// ReactivateAccount restores a deactivated account by password while the grace period lasts.
+func (s *UserServiceServer) ReactivateAccount(ctx context.Context, in *userpb.ReactivateAccountRequest) (*userpb.ReactivateAccountResponse, error) {
+	l := logic.NewReactivateAccountLogic(ctx, s.svcCtx)
+	return l.ReactivateAccount(in)
+}
+
+// ChangePassword replaces user_id's password after checking the old one.
+func (s *UserServiceServer) ChangePassword(ctx context.Context, in *userpb.ChangePasswordRequest) (*userpb.PasswordResponse, error) {
+	l := logic.NewChangePasswordLogic(ctx, s.svcCtx)
+	return l.ChangePassword(in)
+}
+
+// RequestPasswordReset sends a reset token to a verified email or phone without revealing whether the account exists.
+func (s *UserServiceServer) RequestPasswordReset(ctx context.Context, in *userpb.PasswordResetRequest) (*userpb.PasswordResetResponse, error) {
+	l := logic.NewRequestPasswordResetLogic(ctx, s.svcCtx)
+	return l.RequestPasswordReset(in)
+}
+
+// ResetPassword sets a new password with a token from RequestPasswordReset. Tokens are single use.
+func (s *UserServiceServer) ResetPassword(ctx context.Context, in *userpb.ResetPasswordRequest) (*userpb.PasswordResponse, error) {
+	l := logic.NewResetPasswordLogic(ctx, s.svcCtx)
+	return l.ResetPassword(in)
+}
+
+// AdminSearchUsers pages through accounts matching the filters, newest first. Admin only.
+func (s *UserServiceServer) AdminSearchUsers(ctx context.Context, in *userpb.AdminSearchUsersRequest) (*userpb.AdminSearchUsersResponse, error) {
+	l := logic.NewAdminSearchUsersLogic(ctx, s.svcCtx)
+	return l.AdminSearchUsers(in)
+}
+
+// AdminSetUserStatus disables or re-enables an account. Admin only.
+func (s *UserServiceServer) AdminSetUserStatus(ctx context.Context, in *userpb.AdminSetUserStatusRequest) (*userpb.AdminUser, error) {
+	l := logic.NewAdminSetUserStatusLogic(ctx, s.svcCtx)
+	return l.AdminSetUserStatus(in)
+}
+
+// AdminGetUserBindings lists every login method of an account, unverified ones included. Admin only.
+func (s *UserServiceServer) AdminGetUserBindings(ctx context.Context, in *userpb.AdminUserRequest) (*userpb.AdminUserBindingsResponse, error) {
+	l := logic.NewAdminGetUserBindingsLogic(ctx, s.svcCtx)
+	return l.AdminGetUserBindings(in)
+}
@@ -2,12 +2,16 @@ package svc
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/config"
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
 
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/syncx"
 )
 
 type ServiceContext struct {
@@ -15,21 +19,53 @@ type ServiceContext struct {
 	ReadConn  sqlx.SqlConn // Read-only connection (read port)
 	WriteConn sqlx.SqlConn // Read-write connection (write port)
 	Redis     *redis.Redis
-	OSSClient *util.OSSClient
+	// ProfileCache caches t_user_profile rows by user id; nil when disabled.
+	ProfileCache cache.Cache
+	// ObjectStore keeps uploaded images, on the backend chosen by Storage.Driver.
+	ObjectStore util.ObjectStore
+	// CodeSender delivers phone/email verification codes and password reset tokens.
+	CodeSender util.CodeSender
+	// BreachedPasswords holds PasswordPolicy.BreachedListFile; nil when no list is configured.
+	BreachedPasswords *util.BreachedPasswords
 }
 
 func NewServiceContext(c config.Config) (*ServiceContext, error) {
-	ossClient, err := mustNewOssClient(c.Oss)
+	objectStore, err := newObjectStore(c)
 	if err != nil {
 		return nil, err
 	}
+	codeSender, err := util.NewCodeSender(c.Verification.Sender, c.Verification.SenderURL, c.Mode)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.PasswordPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.UsernamePolicy.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.Avatar.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.Background.Validate(); err != nil {
+		return nil, err
+	}
+	breached, err := newBreachedPasswords(c.PasswordPolicy.BreachedListFile)
+	if err != nil {
+		return nil, err
+	}
+
+	redisClient := mustNewRedisClient(c.CacheRedis)
 
 	return &ServiceContext{
-		Config:    c,
-		ReadConn:  mustNewSQLConn(c.Mysql, c.Mysql.RPort),
-		WriteConn: mustNewSQLConn(c.Mysql, c.Mysql.WPort),
-		Redis:     mustNewRedisClient(c.CacheRedis),
-		OSSClient: ossClient,
+		Config:            c,
+		ReadConn:          mustNewSQLConn(c.Mysql, c.Mysql.RPort),
+		WriteConn:         mustNewSQLConn(c.Mysql, c.Mysql.WPort),
+		Redis:             redisClient,
+		ProfileCache:      newProfileCache(c.ProfileCache, redisClient),
+		ObjectStore:       objectStore,
+		CodeSender:        codeSender,
+		BreachedPasswords: breached,
 	}, nil
 }
 
@@ -41,19 +77,60 @@ func mustNewRedisClient(config redis.RedisConf) *redis.Redis {
 	return redis.MustNewRedis(config)
 }
 
-func mustNewOssClient(config config.OssConf) (*util.OSSClient, error) {
-	ossClient, ossErr := util.NewOSSClient(util.OSSConfig{
-		Region:          config.Region,
-		BucketName:      config.Bucketname,
-		BucketURL:       config.Bucketurl,
-		Endpoint:        config.Endpoint,
-		AccessKeyID:     config.AccessKeyID,
-		AccessKeySecret: config.AccessKeySecret,
+// newProfileCache builds the profile cache node. The node shares one singleflight group across
+// callers and adds jitter to every expiry, so hot keys neither stampede nor expire together.
+func newProfileCache(config config.ProfileCacheConf, rds *redis.Redis) cache.Cache {
+	if !config.Enabled {
+		return nil
+	}
+	return cache.NewNode(rds, syncx.NewSingleFlight(), cache.NewStat("user-profile"), sqlx.ErrNotFound,
+		cache.WithExpiry(time.Duration(config.TTLSeconds)*time.Second),
+		cache.WithNotFoundExpiry(time.Duration(config.NotFoundTTLSeconds)*time.Second))
+}
+
+func newBreachedPasswords(path string) (*util.BreachedPasswords, error) {
+	if path == "" {
+		return nil, nil
+	}
+	list, err := util.LoadBreachedPasswords(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load breached password list: %w", err)
+	}
+	logx.Infof("loaded %d breached password hashes from %s", list.Len(), path)
+	return list, nil
+}
+
+func newObjectStore(c config.Config) (util.ObjectStore, error) {
+	store, err := util.NewObjectStore(util.StorageConfig{
+		Driver: c.Storage.Driver,
+		OSS: util.OSSConfig{
+			Region:          c.Oss.Region,
+			BucketName:      c.Oss.Bucketname,
+			BucketURL:       c.Oss.Bucketurl,
+			Endpoint:        c.Oss.Endpoint,
+			AccessKeyID:     c.Oss.AccessKeyID,
+			AccessKeySecret: c.Oss.AccessKeySecret,
+		},
+		S3: util.S3Config{
+			Region:          c.Storage.S3.Region,
+			BucketName:      c.Storage.S3.Bucketname,
+			Endpoint:        c.Storage.S3.Endpoint,
+			AccessKeyID:     c.Storage.S3.AccessKeyID,
+			AccessKeySecret: c.Storage.S3.AccessKeySecret,
+			UsePathStyle:    c.Storage.S3.UsePathStyle,
+		},
+		Local: util.LocalConfig{
+			Root:           c.Storage.Local.Root,
+			ListenOn:       c.Storage.Local.ListenOn,
+			BaseURL:        c.Storage.Local.BaseURL,
+			Secret:         c.Storage.Local.Secret,
+			MaxUploadBytes: c.Storage.Local.MaxUploadBytes,
+		},
 	})
-	if ossErr != nil {
-		return nil, fmt.Errorf("failed to initialize OSS client: %w", ossErr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize object store: %w", err)
 	}
-	return ossClient, nil
+	return store, nil
 }
 
 // buildDSN constructs a MySQL DSN string from MysqlConf with the given port.
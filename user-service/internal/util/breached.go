@@ -0,0 +1,86 @@
+package util
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+const (
+	sha1HexLength     = 40
+	breachPrefixChars = 5
+)
+
+// BreachedPasswords is a local set of SHA-1 hashes of leaked passwords.
+//
+// Hashes are bucketed by their first five hex digits, the same split the Pwned Passwords range
+// API uses for k-anonymity, so a lookup only searches one small sorted bucket and the list can
+// be swapped for per-prefix range files without changing callers. A nil *BreachedPasswords
+// contains nothing.
+type BreachedPasswords struct {
+	buckets map[string][]string
+	size    int
+}
+
+// LoadBreachedPasswords reads a list with one SHA-1 hash per line, optionally followed by
+// ":count" as in the Pwned Passwords downloads. Blank lines and lines starting with '#' are
+// skipped.
+func LoadBreachedPasswords(path string) (*BreachedPasswords, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	list := &BreachedPasswords{buckets: make(map[string][]string)}
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hash, _, _ := strings.Cut(line, ":")
+		hash = strings.ToUpper(hash)
+		if len(hash) != sha1HexLength {
+			return nil, fmt.Errorf("%s:%d: not a SHA-1 hash", path, lineNo)
+		}
+		if _, err := hex.DecodeString(hash); err != nil {
+			return nil, fmt.Errorf("%s:%d: not a SHA-1 hash", path, lineNo)
+		}
+		prefix := hash[:breachPrefixChars]
+		list.buckets[prefix] = append(list.buckets[prefix], hash[breachPrefixChars:])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for prefix, suffixes := range list.buckets {
+		slices.Sort(suffixes)
+		list.buckets[prefix] = slices.Compact(suffixes)
+		list.size += len(list.buckets[prefix])
+	}
+	return list, nil
+}
+
+// Contains reports whether password is on the list.
+func (b *BreachedPasswords) Contains(password string) bool {
+	if b == nil {
+		return false
+	}
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	_, found := slices.BinarySearch(b.buckets[hash[:breachPrefixChars]], hash[breachPrefixChars:])
+	return found
+}
+
+// Len returns the number of distinct hashes on the list.
+func (b *BreachedPasswords) Len() int {
+	if b == nil {
+		return 0
+	}
+	return b.size
+}
@@ -0,0 +1,489 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	localObjectsPath   = "/objects/"
+	localUploadsPath   = "/uploads"
+	localTempPrefix    = ".upload-"
+	localSniffBytes    = 512
+	localShutdownLimit = 5 * time.Second
+
+	queryExpires     = "X-Expires"
+	queryContentType = "X-Content-Type"
+	querySignature   = "X-Signature"
+
+	formPolicy    = "policy"
+	formSignature = "signature"
+	formFile      = "file"
+	// localFormOverhead bounds the multipart framing and fields sent with a POST upload.
+	localFormOverhead = 64 << 10
+)
+
+var errObjectSize = errors.New("object size is outside the allowed range")
+
+// localPostPolicy is the document a LocalStore POST form carries, base64-encoded and signed.
+type localPostPolicy struct {
+	Expires     int64  `json:"expires"`
+	Key         string `json:"key"`
+	ContentType string `json:"contentType"`
+	MinBytes    int64  `json:"minBytes"`
+	MaxBytes    int64  `json:"maxBytes"`
+}
+
+// LocalConfig configures the local-disk store. It is meant for development and CI, where no
+// cloud bucket is available.
+type LocalConfig struct {
+	// Root is the directory objects are written to.
+	Root string
+	// ListenOn is the address the embedded HTTP handler serves presigned URLs on.
+	ListenOn string
+	// BaseURL is how clients reach ListenOn, e.g. http://localhost:8081.
+	BaseURL string
+	// Secret signs URLs. When empty a random secret is generated, so URLs stop working on
+	// restart and are not accepted by other instances.
+	Secret string
+	// MaxUploadBytes caps a single PUT or POST upload.
+	MaxUploadBytes int64
+}
+
+var _ ObjectStore = (*LocalStore)(nil)
+
+// LocalStore is the ObjectStore backed by a directory. Presigned URLs point at its own HTTP
+// handler and carry an HMAC-SHA256 signature over the method, object name, expiry and content
+// type, the same guarantees a cloud presigned URL gives.
+type LocalStore struct {
+	root           string
+	baseURL        string
+	listenOn       string
+	secret         []byte
+	maxUploadBytes int64
+	server         *http.Server
+}
+
+func NewLocalStore(cfg LocalConfig) (*LocalStore, error) {
+	if strings.TrimSpace(cfg.Root) == "" {
+		return nil, errors.New("local storage root is required")
+	}
+	root, err := filepath.Abs(cfg.Root)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("create local storage root failed: %w", err)
+	}
+	if _, err := url.Parse(cfg.BaseURL); err != nil || cfg.BaseURL == "" {
+		return nil, fmt.Errorf("invalid local storage base URL %q", cfg.BaseURL)
+	}
+
+	secret := []byte(cfg.Secret)
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+		logx.Infof("local storage: no secret configured, presigned URLs are only valid until restart")
+	}
+	store := &LocalStore{
+		root:           root,
+		baseURL:        strings.TrimRight(cfg.BaseURL, "/"),
+		listenOn:       cfg.ListenOn,
+		secret:         secret,
+		maxUploadBytes: cfg.MaxUploadBytes,
+	}
+	store.server = &http.Server{Addr: cfg.ListenOn, Handler: store, ReadHeaderTimeout: 10 * time.Second}
+	return store, nil
+}
+
+func (s *LocalStore) PresignPut(_ context.Context, objectName string, expires time.Duration, contentType string) (*PresignResult, error) {
+	return s.presign(http.MethodPut, objectName, expires, contentType)
+}
+
+func (s *LocalStore) PresignGet(_ context.Context, objectName string, expires time.Duration) (*PresignResult, error) {
+	return s.presign(http.MethodGet, objectName, expires, "")
+}
+
+func (s *LocalStore) PresignPost(_ context.Context, objectName string, expires time.Duration, policy PostPolicy) (*PresignPostResult, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if expires <= 0 {
+		return nil, errors.New("expiration must be positive")
+	}
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+	if policy.MaxBytes > s.maxUploadBytes {
+		return nil, fmt.Errorf("post policy allows %d bytes, local storage accepts at most %d", policy.MaxBytes, s.maxUploadBytes)
+	}
+	document, err := json.Marshal(localPostPolicy{
+		Expires:     time.Now().Add(expires).Unix(),
+		Key:         objectName,
+		ContentType: policy.ContentType,
+		MinBytes:    policy.MinBytes,
+		MaxBytes:    policy.MaxBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	encoded := base64.StdEncoding.EncodeToString(document)
+	return &PresignPostResult{
+		URL: s.baseURL + localUploadsPath,
+		Fields: map[string]string{
+			"key":          objectName,
+			"Content-Type": policy.ContentType,
+			formPolicy:     encoded,
+			formSignature:  s.signPolicy(encoded),
+		},
+	}, nil
+}
+
+func (s *LocalStore) presign(method, objectName string, expires time.Duration, contentType string) (*PresignResult, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if expires <= 0 {
+		return nil, errors.New("expiration must be positive")
+	}
+	expiresAt := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	query := url.Values{}
+	query.Set(queryExpires, expiresAt)
+	if contentType != "" {
+		query.Set(queryContentType, contentType)
+	}
+	query.Set(querySignature, s.sign(method, objectName, expiresAt, contentType))
+
+	result := &PresignResult{URL: s.baseURL + localObjectsPath + objectName + "?" + query.Encode()}
+	if contentType != "" {
+		result.SignedHeaders = map[string]string{"Content-Type": contentType}
+	}
+	return result, nil
+}
+
+func (s *LocalStore) sign(method, objectName, expiresAt, contentType string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(method + "\n" + objectName + "\n" + expiresAt + "\n" + contentType))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signPolicy signs a POST policy. The method line keeps it from ever equalling a URL signature.
+func (s *LocalStore) signPolicy(encodedPolicy string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(http.MethodPost + "\n" + encodedPolicy))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalStore) Get(_ context.Context, objectName string) (io.ReadCloser, error) {
+	file, _, err := s.open(objectName)
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Put ignores contentType: Head sniffs the type from the content instead.
+func (s *LocalStore) Put(_ context.Context, objectName string, data []byte, _ string) error {
+	if err := ValidateObjectName(objectName); err != nil {
+		return err
+	}
+	size := int64(len(data))
+	if err := s.write(objectName, bytes.NewReader(data), size, size); err != nil {
+		return fmt.Errorf("put object failed (key: %s): %w", objectName, err)
+	}
+	return nil
+}
+
+// Head reports the content type sniffed from the first bytes, since files on disk carry none.
+func (s *LocalStore) Head(_ context.Context, objectName string) (*ObjectInfo, error) {
+	file, info, err := s.open(objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	head := make([]byte, localSniffBytes)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &ObjectInfo{Size: info.Size(), ContentType: http.DetectContentType(head[:n])}, nil
+}
+
+func (s *LocalStore) Delete(_ context.Context, objectName string) error {
+	if err := ValidateObjectName(objectName); err != nil {
+		return err
+	}
+	if err := os.Remove(s.path(objectName)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete object failed (key: %s): %w", objectName, err)
+	}
+	return nil
+}
+
+func (s *LocalStore) List(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	if err := ValidateObjectName(prefix); err != nil {
+		return nil, err
+	}
+	// Walk the deepest directory the prefix names, then filter on the full prefix.
+	dir := s.path(path.Dir(prefix + "x"))
+	var objects []ObjectInfo
+	err := filepath.WalkDir(dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), localTempPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, name)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, ObjectInfo{Key: key, Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list objects failed (prefix: %s): %w", prefix, err)
+	}
+	return objects, nil
+}
+
+func (s *LocalStore) path(objectName string) string {
+	return filepath.Join(s.root, filepath.FromSlash(objectName))
+}
+
+func (s *LocalStore) open(objectName string) (*os.File, os.FileInfo, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, nil, err
+	}
+	file, err := os.Open(s.path(objectName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, ErrObjectNotFound
+		}
+		return nil, nil, err
+	}
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		file.Close()
+		return nil, nil, ErrObjectNotFound
+	}
+	return file, info, nil
+}
+
+// ServeHTTP serves the URLs handed out by PresignPut, PresignGet and PresignPost.
+func (s *LocalStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == localUploadsPath {
+		s.servePost(w, r)
+		return
+	}
+	objectName := strings.TrimPrefix(r.URL.Path, localObjectsPath)
+	if !strings.HasPrefix(r.URL.Path, localObjectsPath) || ValidateObjectName(objectName) != nil {
+		http.NotFound(w, r)
+		return
+	}
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	expiresAt := query.Get(queryExpires)
+	contentType := query.Get(queryContentType)
+	expected := s.sign(method, objectName, expiresAt, contentType)
+	if !hmac.Equal([]byte(expected), []byte(query.Get(querySignature))) {
+		http.Error(w, "signature mismatch", http.StatusForbidden)
+		return
+	}
+	if unix, err := strconv.ParseInt(expiresAt, 10, 64); err != nil || time.Now().Unix() > unix {
+		http.Error(w, "request has expired", http.StatusForbidden)
+		return
+	}
+
+	if method == http.MethodPut {
+		if contentType != "" && r.Header.Get("Content-Type") != contentType {
+			http.Error(w, "content type does not match the signed one", http.StatusForbidden)
+			return
+		}
+		if err := s.write(objectName, r.Body, 0, s.maxUploadBytes); err != nil {
+			if errors.Is(err, errObjectSize) {
+				http.Error(w, "object too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			logx.WithContext(r.Context()).Errorf("local storage: write %s failed: %v", objectName, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	file, info, err := s.open(objectName)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+	http.ServeContent(w, r, "", info.ModTime(), file)
+}
+
+// servePost handles a multipart POST upload. Like the cloud services, it reads the form fields
+// up to the file part, checks them against the signed policy, and only then stores the file.
+func (s *LocalStore) servePost(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, s.maxUploadBytes+localFormOverhead)
+	form, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, "expected a multipart form", http.StatusBadRequest)
+		return
+	}
+
+	fields := make(map[string]string)
+	for {
+		part, err := form.NextPart()
+		if err != nil {
+			http.Error(w, "missing file part", http.StatusBadRequest)
+			return
+		}
+		if part.FormName() != formFile {
+			value, err := io.ReadAll(io.LimitReader(part, localFormOverhead))
+			if err != nil {
+				http.Error(w, "invalid form field", http.StatusBadRequest)
+				return
+			}
+			fields[part.FormName()] = string(value)
+			continue
+		}
+
+		policy, status, msg := s.checkPostPolicy(fields)
+		if status != http.StatusOK {
+			http.Error(w, msg, status)
+			return
+		}
+		if err := s.write(policy.Key, part, policy.MinBytes, policy.MaxBytes); err != nil {
+			var tooLarge *http.MaxBytesError
+			switch {
+			case errors.Is(err, errObjectSize):
+				http.Error(w, "object size is outside the signed range", http.StatusBadRequest)
+				return
+			case errors.As(err, &tooLarge):
+				http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			logx.WithContext(r.Context()).Errorf("local storage: write %s failed: %v", policy.Key, err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+}
+
+// checkPostPolicy verifies the signature and expiry of a POST form and that its fields match the
+// policy. It returns http.StatusOK and the policy when the upload may proceed.
+func (s *LocalStore) checkPostPolicy(fields map[string]string) (*localPostPolicy, int, string) {
+	encoded := fields[formPolicy]
+	expected := s.signPolicy(encoded)
+	if encoded == "" || !hmac.Equal([]byte(expected), []byte(fields[formSignature])) {
+		return nil, http.StatusForbidden, "signature mismatch"
+	}
+	document, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, http.StatusBadRequest, "invalid policy"
+	}
+	var policy localPostPolicy
+	if err := json.Unmarshal(document, &policy); err != nil {
+		return nil, http.StatusBadRequest, "invalid policy"
+	}
+	if time.Now().Unix() > policy.Expires {
+		return nil, http.StatusForbidden, "request has expired"
+	}
+	if fields["key"] != policy.Key || ValidateObjectName(policy.Key) != nil {
+		return nil, http.StatusForbidden, "key does not match the policy"
+	}
+	if fields["Content-Type"] != policy.ContentType {
+		return nil, http.StatusForbidden, "content type does not match the policy"
+	}
+	return &policy, http.StatusOK, ""
+}
+
+// write stores body under objectName through a temporary file, so readers never see a partial
+// object. A body shorter than minBytes or longer than maxBytes is discarded with errObjectSize.
+func (s *LocalStore) write(objectName string, body io.Reader, minBytes, maxBytes int64) error {
+	target := s.path(objectName)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(filepath.Dir(target), localTempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	written, err := io.Copy(temp, io.LimitReader(body, maxBytes+1))
+	if err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	if written < minBytes || written > maxBytes {
+		return errObjectSize
+	}
+	return os.Rename(temp.Name(), target)
+}
+
+// Start serves presigned URLs on ListenOn until Stop is called.
+func (s *LocalStore) Start() {
+	logx.Infof("local storage: serving %s on %s", s.root, s.listenOn)
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logx.Errorf("local storage: http server stopped: %v", err)
+	}
+}
+
+func (s *LocalStore) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), localShutdownLimit)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		logx.Errorf("local storage: shutdown failed: %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Storage drivers accepted by NewObjectStore.
+const (
+	StorageDriverOSS   = "oss"
+	StorageDriverS3    = "s3"
+	StorageDriverLocal = "local"
+)
+
+// ErrObjectNotFound is returned by Head when the object does not exist.
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectStore keeps uploaded files. Clients never stream through user-service: they upload and
+// download with presigned URLs, and the service only inspects, derives and deletes objects.
+type ObjectStore interface {
+	// PresignPut returns a URL the client can PUT the object to until expires has passed.
+	// A non-empty contentType must be sent as the request's Content-Type.
+	PresignPut(ctx context.Context, objectName string, expires time.Duration, contentType string) (*PresignResult, error)
+	// PresignPost returns a form the client can POST the object with until expires has passed.
+	// Unlike a PUT URL, the signed policy also pins the content type and the size range.
+	PresignPost(ctx context.Context, objectName string, expires time.Duration, policy PostPolicy) (*PresignPostResult, error)
+	// PresignGet returns a URL the object can be downloaded from until expires has passed.
+	PresignGet(ctx context.Context, objectName string, expires time.Duration) (*PresignResult, error)
+	// Get opens the object for reading, or returns ErrObjectNotFound. The caller closes it.
+	Get(ctx context.Context, objectName string) (io.ReadCloser, error)
+	// Put stores data under objectName, replacing any existing object. It is meant for files the
+	// service produces itself; clients upload through presigned requests.
+	Put(ctx context.Context, objectName string, data []byte, contentType string) error
+	// Head returns the object's size and content type, or ErrObjectNotFound.
+	Head(ctx context.Context, objectName string) (*ObjectInfo, error)
+	// Delete removes one object. Deleting a missing object is not an error.
+	Delete(ctx context.Context, objectName string) error
+	// List returns every object whose name starts with prefix.
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+}
+
+type PresignResult struct {
+	URL           string
+	SignedHeaders map[string]string
+}
+
+// PostPolicy holds the conditions a POST upload must meet.
+type PostPolicy struct {
+	ContentType string
+	MinBytes    int64
+	MaxBytes    int64
+}
+
+func (p PostPolicy) validate() error {
+	if strings.TrimSpace(p.ContentType) == "" {
+		return errors.New("post policy content type is required")
+	}
+	if p.MinBytes < 0 || p.MaxBytes <= 0 || p.MinBytes > p.MaxBytes {
+		return fmt.Errorf("invalid post policy size range %d-%d", p.MinBytes, p.MaxBytes)
+	}
+	return nil
+}
+
+// PresignPostResult is a multipart/form-data upload: send every field in Fields, then the
+// object itself as the last part, named "file", to URL.
+type PresignPostResult struct {
+	URL    string
+	Fields map[string]string
+}
+
+// ObjectInfo describes a stored object. Key is only set by List.
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
+
+// StorageConfig selects and configures an ObjectStore.
+type StorageConfig struct {
+	Driver string
+	OSS    OSSConfig
+	S3     S3Config
+	Local  LocalConfig
+}
+
+// NewObjectStore builds the store selected by cfg.Driver.
+func NewObjectStore(cfg StorageConfig) (ObjectStore, error) {
+	var (
+		store ObjectStore
+		err   error
+	)
+	switch cfg.Driver {
+	case "", StorageDriverOSS:
+		store, err = NewOSSClient(cfg.OSS)
+	case StorageDriverS3:
+		store, err = NewS3Store(cfg.S3)
+	case StorageDriverLocal:
+		store, err = NewLocalStore(cfg.Local)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// PresignGetBatch presigns many objects with one shared expiry and returns URLs by object name.
+// Duplicates are signed once. Objects that fail are left out and reported in the joined error.
+func PresignGetBatch(ctx context.Context, store ObjectStore, objectNames []string, expires time.Duration) (map[string]string, error) {
+	if expires <= 0 {
+		return nil, errors.New("expiration must be positive")
+	}
+	urls := make(map[string]string, len(objectNames))
+	var errs []error
+	for _, objectName := range objectNames {
+		if _, ok := urls[objectName]; ok {
+			continue
+		}
+		result, err := store.PresignGet(ctx, objectName, expires)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", objectName, err))
+			continue
+		}
+		urls[objectName] = result.URL
+	}
+	return urls, errors.Join(errs...)
+}
+
+// DeletePrefix removes every object whose name starts with prefix and returns how many were
+// deleted. The prefix must end with "/" so it cannot match a sibling directory.
+func DeletePrefix(ctx context.Context, store ObjectStore, prefix string) (int, error) {
+	if err := ValidateObjectName(prefix); err != nil {
+		return 0, err
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		return 0, errors.New("prefix must end with '/'")
+	}
+	objects, err := store.List(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+	for i, object := range objects {
+		if err := store.Delete(ctx, object.Key); err != nil {
+			return i, err
+		}
+	}
+	return len(objects), nil
+}
@@ -1,9 +1,17 @@
 package util
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"regexp"
 	"strings"
@@ -22,6 +30,8 @@ const (
 	envOSSAccessKeySecret = "OSS_ACCESS_KEY_SECRET"
 
 	objectNameRegex = `^[a-zA-Z0-9\-_\./]+$`
+
+	ossPostSignatureVersion = "OSS4-HMAC-SHA256"
 )
 
 var validObjectNameRegex = regexp.MustCompile(objectNameRegex)
@@ -35,17 +45,18 @@ type OSSConfig struct {
 	AccessKeySecret string
 }
 
+// OSSClient is the ObjectStore backed by Alibaba Cloud OSS.
 type OSSClient struct {
 	oss        *oss.Client
 	bucketName string
 	bucketURL  string
+	endpoint   string
 	region     string
+	// credentials signs POST policies, which the SDK has no helper for.
+	credentials credentials.CredentialsProvider
 }
 
-type PresignResult struct {
-	URL           string
-	SignedHeaders map[string]string
-}
+var _ ObjectStore = (*OSSClient)(nil)
 
 func NewOSSClient(cfg OSSConfig) (*OSSClient, error) {
 	region, err := getConfigValue(cfg.Region, envOSSRegion, true)
@@ -77,8 +88,9 @@ func NewOSSClient(cfg OSSConfig) (*OSSClient, error) {
 		endpoint = fmt.Sprintf("oss-%s.aliyuncs.com", region)
 	}
 
+	provider := credentials.NewEnvironmentVariableCredentialsProvider()
 	ossCfg := oss.LoadDefaultConfig().
-		WithCredentialsProvider(credentials.NewEnvironmentVariableCredentialsProvider()).
+		WithCredentialsProvider(provider).
 		WithRegion(region).
 		WithEndpoint(endpoint).
 		WithConnectTimeout(10 * time.Second).
@@ -86,10 +98,12 @@ func NewOSSClient(cfg OSSConfig) (*OSSClient, error) {
 		WithRetryMaxAttempts(3)
 
 	return &OSSClient{
-		oss:        oss.NewClient(ossCfg),
-		bucketName: bucketName,
-		bucketURL:  bucketURL,
-		region:     region,
+		oss:         oss.NewClient(ossCfg),
+		bucketName:  bucketName,
+		bucketURL:   bucketURL,
+		endpoint:    endpoint,
+		region:      region,
+		credentials: provider,
 	}, nil
 }
 
@@ -133,6 +147,85 @@ func (c *OSSClient) PresignPut(ctx context.Context, objectName string, expires t
 	}, nil
 }
 
+// PresignPost signs a V4 POST policy. See
+// https://help.aliyun.com/zh/oss/developer-reference/signature-version-4-recommend
+func (c *OSSClient) PresignPost(ctx context.Context, objectName string, expires time.Duration, policy PostPolicy) (*PresignPostResult, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if expires <= 0 {
+		return nil, errors.New("expiration must be positive")
+	}
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+	cred, err := c.credentials.GetCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load OSS credentials failed: %w", err)
+	}
+
+	now := time.Now().UTC()
+	date := now.Format("20060102")
+	credential := fmt.Sprintf("%s/%s/%s/oss/aliyun_v4_request", cred.AccessKeyID, date, c.region)
+	fields := map[string]string{
+		"key":                     objectName,
+		"Content-Type":            policy.ContentType,
+		"x-oss-signature-version": ossPostSignatureVersion,
+		"x-oss-credential":        credential,
+		"x-oss-date":              now.Format("20060102T150405Z"),
+	}
+	if cred.SecurityToken != "" {
+		fields["x-oss-security-token"] = cred.SecurityToken
+	}
+
+	conditions := []any{
+		map[string]string{"bucket": c.bucketName},
+		[]any{"eq", "$key", objectName},
+		[]any{"eq", "$Content-Type", policy.ContentType},
+		[]any{"content-length-range", policy.MinBytes, policy.MaxBytes},
+	}
+	for _, name := range []string{"x-oss-signature-version", "x-oss-credential", "x-oss-date", "x-oss-security-token"} {
+		if value, ok := fields[name]; ok {
+			conditions = append(conditions, map[string]string{name: value})
+		}
+	}
+	document, err := json.Marshal(map[string]any{
+		"expiration": now.Add(expires).Format("2006-01-02T15:04:05.000Z"),
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	encoded := base64.StdEncoding.EncodeToString(document)
+	fields["policy"] = encoded
+	fields["x-oss-signature"] = signOSSPolicy(cred.AccessKeySecret, date, c.region, encoded)
+
+	return &PresignPostResult{URL: c.uploadURL(), Fields: fields}, nil
+}
+
+// signOSSPolicy derives the V4 signing key for date and region and signs the encoded policy.
+func signOSSPolicy(secret, date, region, encodedPolicy string) string {
+	key := []byte("aliyun_v4" + secret)
+	for _, part := range []string{date, region, "oss", "aliyun_v4_request", encodedPolicy} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+	return hex.EncodeToString(key)
+}
+
+// uploadURL is the bucket's virtual-hosted address, which POST uploads are sent to.
+func (c *OSSClient) uploadURL() string {
+	if c.bucketURL != "" {
+		return strings.TrimRight(c.bucketURL, "/")
+	}
+	host := c.endpoint
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	return "https://" + c.bucketName + "." + strings.TrimRight(host, "/")
+}
+
 func (c *OSSClient) PresignGet(ctx context.Context, objectName string, expires time.Duration) (*PresignResult, error) {
 	if err := ValidateObjectName(objectName); err != nil {
 		return nil, err
@@ -156,6 +249,96 @@ func (c *OSSClient) PresignGet(ctx context.Context, objectName string, expires t
 	}, nil
 }
 
+func (c *OSSClient) Get(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	result, err := c.oss.GetObject(ctx, &oss.GetObjectRequest{
+		Bucket: oss.Ptr(c.bucketName),
+		Key:    oss.Ptr(objectName),
+	})
+	if err != nil {
+		var serviceErr *oss.ServiceError
+		if errors.As(err, &serviceErr) && serviceErr.StatusCode == http.StatusNotFound {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("get object failed (key: %s): %w", objectName, err)
+	}
+	return result.Body, nil
+}
+
+func (c *OSSClient) Put(ctx context.Context, objectName string, data []byte, contentType string) error {
+	if err := ValidateObjectName(objectName); err != nil {
+		return err
+	}
+	request := &oss.PutObjectRequest{
+		Bucket:        oss.Ptr(c.bucketName),
+		Key:           oss.Ptr(objectName),
+		ContentLength: oss.Ptr(int64(len(data))),
+		Body:          bytes.NewReader(data),
+	}
+	if contentType != "" {
+		request.ContentType = oss.Ptr(contentType)
+	}
+	if _, err := c.oss.PutObject(ctx, request); err != nil {
+		return fmt.Errorf("put object failed (key: %s): %w", objectName, err)
+	}
+	return nil
+}
+
+func (c *OSSClient) Head(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	result, err := c.oss.HeadObject(ctx, &oss.HeadObjectRequest{
+		Bucket: oss.Ptr(c.bucketName),
+		Key:    oss.Ptr(objectName),
+	})
+	if err != nil {
+		var serviceErr *oss.ServiceError
+		if errors.As(err, &serviceErr) && serviceErr.StatusCode == http.StatusNotFound {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("head object failed (key: %s): %w", objectName, err)
+	}
+	return &ObjectInfo{
+		Size:        result.ContentLength,
+		ContentType: oss.ToString(result.ContentType),
+	}, nil
+}
+
+func (c *OSSClient) Delete(ctx context.Context, objectName string) error {
+	if err := ValidateObjectName(objectName); err != nil {
+		return err
+	}
+	if _, err := c.oss.DeleteObject(ctx, &oss.DeleteObjectRequest{
+		Bucket: oss.Ptr(c.bucketName),
+		Key:    oss.Ptr(objectName),
+	}); err != nil {
+		return fmt.Errorf("delete object failed (key: %s): %w", objectName, err)
+	}
+	return nil
+}
+
+func (c *OSSClient) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	paginator := c.oss.NewListObjectsV2Paginator(&oss.ListObjectsV2Request{
+		Bucket:  oss.Ptr(c.bucketName),
+		Prefix:  oss.Ptr(prefix),
+		MaxKeys: 1000,
+	})
+	for paginator.HasNext() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list objects failed (prefix: %s): %w", prefix, err)
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, ObjectInfo{Key: oss.ToString(object.Key), Size: object.Size})
+		}
+	}
+	return objects, nil
+}
+
 func (c *OSSClient) BucketName() string {
 	return c.bucketName
 }
@@ -0,0 +1,230 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3Config configures an S3-compatible store: AWS S3, MinIO, Ceph RGW, Cloudflare R2 and the like.
+type S3Config struct {
+	Region     string
+	BucketName string
+	// Endpoint overrides the AWS endpoint, e.g. http://localhost:9000 for MinIO.
+	Endpoint        string
+	AccessKeyID     string
+	AccessKeySecret string
+	// UsePathStyle addresses the bucket as {endpoint}/{bucket}, which most self-hosted servers need.
+	UsePathStyle bool
+}
+
+var _ ObjectStore = (*S3Store)(nil)
+
+// S3Store is the ObjectStore backed by an S3-compatible service.
+type S3Store struct {
+	client     *s3.Client
+	presigner  *s3.PresignClient
+	bucketName string
+}
+
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if strings.TrimSpace(cfg.Region) == "" {
+		return nil, errors.New("s3 region is required")
+	}
+	if strings.TrimSpace(cfg.BucketName) == "" {
+		return nil, errors.New("s3 bucket name is required")
+	}
+
+	awsCfg := aws.Config{Region: strings.TrimSpace(cfg.Region)}
+	if cfg.AccessKeyID != "" {
+		awsCfg.Credentials = credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.AccessKeySecret, "")
+	}
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if endpoint := strings.TrimSpace(cfg.Endpoint); endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+		o.UsePathStyle = cfg.UsePathStyle
+	})
+	return &S3Store{
+		client:     client,
+		presigner:  s3.NewPresignClient(client),
+		bucketName: strings.TrimSpace(cfg.BucketName),
+	}, nil
+}
+
+func (s *S3Store) PresignPut(ctx context.Context, objectName string, expires time.Duration, contentType string) (*PresignResult, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if expires <= 0 {
+		return nil, errors.New("expiration must be positive")
+	}
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectName),
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	result, err := s.presigner.PresignPutObject(ctx, input, s3.WithPresignExpires(expires))
+	if err != nil {
+		return nil, fmt.Errorf("generate put presign URL failed (key: %s): %w", objectName, err)
+	}
+	return &PresignResult{URL: result.URL, SignedHeaders: flattenHeader(result.SignedHeader)}, nil
+}
+
+func (s *S3Store) PresignPost(ctx context.Context, objectName string, expires time.Duration, policy PostPolicy) (*PresignPostResult, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if expires <= 0 {
+		return nil, errors.New("expiration must be positive")
+	}
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+	result, err := s.presigner.PresignPostObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectName),
+	}, func(o *s3.PresignPostOptions) {
+		o.Expires = expires
+		o.Conditions = []any{
+			[]any{"eq", "$Content-Type", policy.ContentType},
+			[]any{"content-length-range", policy.MinBytes, policy.MaxBytes},
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate post policy failed (key: %s): %w", objectName, err)
+	}
+	// The policy requires the field, but the SDK only returns the ones it adds itself.
+	result.Values["Content-Type"] = policy.ContentType
+	return &PresignPostResult{URL: result.URL, Fields: result.Values}, nil
+}
+
+func (s *S3Store) PresignGet(ctx context.Context, objectName string, expires time.Duration) (*PresignResult, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	if expires <= 0 {
+		return nil, errors.New("expiration must be positive")
+	}
+	result, err := s.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectName),
+	}, s3.WithPresignExpires(expires))
+	if err != nil {
+		return nil, fmt.Errorf("generate get presign URL failed (key: %s): %w", objectName, err)
+	}
+	return &PresignResult{URL: result.URL, SignedHeaders: flattenHeader(result.SignedHeader)}, nil
+}
+
+func (s *S3Store) Get(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	result, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("get object failed (key: %s): %w", objectName, err)
+	}
+	return result.Body, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, objectName string, data []byte, contentType string) error {
+	if err := ValidateObjectName(objectName); err != nil {
+		return err
+	}
+	input := &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucketName),
+		Key:           aws.String(objectName),
+		ContentLength: aws.Int64(int64(len(data))),
+		Body:          bytes.NewReader(data),
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	if _, err := s.client.PutObject(ctx, input); err != nil {
+		return fmt.Errorf("put object failed (key: %s): %w", objectName, err)
+	}
+	return nil
+}
+
+func (s *S3Store) Head(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	if err := ValidateObjectName(objectName); err != nil {
+		return nil, err
+	}
+	result, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("head object failed (key: %s): %w", objectName, err)
+	}
+	return &ObjectInfo{
+		Size:        aws.ToInt64(result.ContentLength),
+		ContentType: aws.ToString(result.ContentType),
+	}, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, objectName string) error {
+	if err := ValidateObjectName(objectName); err != nil {
+		return err
+	}
+	if _, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectName),
+	}); err != nil {
+		return fmt.Errorf("delete object failed (key: %s): %w", objectName, err)
+	}
+	return nil
+}
+
+func (s *S3Store) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket:  aws.String(s.bucketName),
+		Prefix:  aws.String(prefix),
+		MaxKeys: aws.Int32(1000),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list objects failed (prefix: %s): %w", prefix, err)
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, ObjectInfo{Key: aws.ToString(object.Key), Size: aws.ToInt64(object.Size)})
+		}
+	}
+	return objects, nil
+}
+
+// flattenHeader keeps the first value of every header, matching PresignResult.SignedHeaders.
+func flattenHeader(header http.Header) map[string]string {
+	flat := make(map[string]string, len(header))
+	for name, values := range header {
+		if len(values) > 0 {
+			flat[name] = values[0]
+		}
+	}
+	return flat
+}
@@ -22,9 +22,10 @@ const (
 )
 
 type VerifyPasswordRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// username is the login identifier: a username, a verified email or a verified phone number.
+	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,11 +75,13 @@ func (x *VerifyPasswordRequest) GetPassword() string {
 }
 
 type VerifyPasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Roles         []string               `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send metadata "x-error-mode: status" to receive gRPC status errors instead.
+	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Deprecated: see code.
+	Message       string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UserId        string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles         []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -202,8 +205,9 @@ func (x *RegisterRequest) GetType() int32 {
 }
 
 type RegisterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send metadata "x-error-mode: status" to receive gRPC status errors instead.
+	Code          int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -298,19 +302,21 @@ func (x *UserDataRequest) GetUserInfo() *UserInfo {
 }
 
 type UserInfo struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Nickname        string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Avatar          string                 `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Gender          int32                  `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
-	Birthday        string                 `protobuf:"bytes,4,opt,name=birthday,proto3" json:"birthday,omitempty"`
-	Bio             string                 `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
-	BackgroundImage string                 `protobuf:"bytes,6,opt,name=background_image,json=backgroundImage,proto3" json:"background_image,omitempty"`
-	Country         string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
-	Province        string                 `protobuf:"bytes,8,opt,name=province,proto3" json:"province,omitempty"`
-	City            string                 `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
-	School          string                 `protobuf:"bytes,10,opt,name=school,proto3" json:"school,omitempty"`
-	Major           string                 `protobuf:"bytes,11,opt,name=major,proto3" json:"major,omitempty"`
-	GraduationYear  int32                  `protobuf:"varint,12,opt,name=graduation_year,json=graduationYear,proto3" json:"graduation_year,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Nickname string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// avatar and background_image only reset an image: avatar accepts "avatars/default_avatar.jpg",
+	// background_image accepts "none" to clear it. Images are uploaded through SetUserMedia.
+	Avatar          string `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Gender          int32  `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	Birthday        string `protobuf:"bytes,4,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Bio             string `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	BackgroundImage string `protobuf:"bytes,6,opt,name=background_image,json=backgroundImage,proto3" json:"background_image,omitempty"`
+	Country         string `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Province        string `protobuf:"bytes,8,opt,name=province,proto3" json:"province,omitempty"`
+	City            string `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
+	School          string `protobuf:"bytes,10,opt,name=school,proto3" json:"school,omitempty"`
+	Major           string `protobuf:"bytes,11,opt,name=major,proto3" json:"major,omitempty"`
+	GraduationYear  int32  `protobuf:"varint,12,opt,name=graduation_year,json=graduationYear,proto3" json:"graduation_year,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -446,8 +452,11 @@ type UserDataResponse struct {
 	GraduationYear  int32                  `protobuf:"varint,13,opt,name=graduation_year,json=graduationYear,proto3" json:"graduation_year,omitempty"`
 	CreatedAt       string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// avatar_url and background_image_url are display URLs for avatar and background_image.
+	AvatarUrl          string `protobuf:"bytes,16,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	BackgroundImageUrl string `protobuf:"bytes,17,opt,name=background_image_url,json=backgroundImageUrl,proto3" json:"background_image_url,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UserDataResponse) Reset() {
@@ -585,16 +594,145 @@ func (x *UserDataResponse) GetUpdatedAt() string {
 	return ""
 }
 
-type UserAvatarRequest struct {
+func (x *UserDataResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UserDataResponse) GetBackgroundImageUrl() string {
+	if x != nil {
+		return x.BackgroundImageUrl
+	}
+	return ""
+}
+
+type UserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRolesRequest) Reset() {
+	*x = UserRolesRequest{}
+	mi := &file_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRolesRequest) ProtoMessage() {}
+
+func (x *UserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRolesRequest.ProtoReflect.Descriptor instead.
+func (*UserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserRolesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRolesResponse) Reset() {
+	*x = UserRolesResponse{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRolesResponse) ProtoMessage() {}
+
+func (x *UserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRolesResponse.ProtoReflect.Descriptor instead.
+func (*UserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserRolesResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserRolesResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserRolesResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type UserAvatarRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// object_key is the key returned by SetUserAvatar; required by ConfirmUserAvatar.
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// content_type and size describe the file about to be uploaded; required by SetUserAvatar.
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// avatar_size picks the variant GetUserAvatar returns, in pixels: 64, 128 or 512, rounded up.
+	// 0 returns the largest.
+	AvatarSize int32 `protobuf:"varint,5,opt,name=avatar_size,json=avatarSize,proto3" json:"avatar_size,omitempty"`
+	// slot is the image SetUserAvatar and ConfirmUserAvatar work on: "avatar" (default) or "background".
+	// Deprecated: use SetUserMedia and ConfirmUserMedia for other slots.
+	Slot          string `protobuf:"bytes,6,opt,name=slot,proto3" json:"slot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserAvatarRequest) Reset() {
 	*x = UserAvatarRequest{}
-	mi := &file_user_proto_msgTypes[7]
+	mi := &file_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +744,7 @@ func (x *UserAvatarRequest) String() string {
 func (*UserAvatarRequest) ProtoMessage() {}
 
 func (x *UserAvatarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[7]
+	mi := &file_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +757,7 @@ func (x *UserAvatarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAvatarRequest.ProtoReflect.Descriptor instead.
 func (*UserAvatarRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{7}
+	return file_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserAvatarRequest) GetUserId() string {
@@ -629,16 +767,60 @@ func (x *UserAvatarRequest) GetUserId() string {
 	return ""
 }
 
+func (x *UserAvatarRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *UserAvatarRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UserAvatarRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UserAvatarRequest) GetAvatarSize() int32 {
+	if x != nil {
+		return x.AvatarSize
+	}
+	return 0
+}
+
+func (x *UserAvatarRequest) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
 type UserAvatarResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// avatar_url is the presigned download URL, or for SetUserAvatar the URL to POST the upload to.
+	AvatarUrl string `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// object_key is set by SetUserAvatar and ConfirmUserAvatar.
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// form_fields are set by SetUserAvatar and must be sent with the upload, followed by the file
+	// as the "file" part.
+	FormFields map[string]string `protobuf:"bytes,3,rep,name=form_fields,json=formFields,proto3" json:"form_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// processing is set by ConfirmUserAvatar when the upload was queued for processing. The
+	// current image stays in place, and avatar_url empty, until the processed one replaces it.
+	Processing    bool `protobuf:"varint,4,opt,name=processing,proto3" json:"processing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserAvatarResponse) Reset() {
 	*x = UserAvatarResponse{}
-	mi := &file_user_proto_msgTypes[8]
+	mi := &file_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +832,7 @@ func (x *UserAvatarResponse) String() string {
 func (*UserAvatarResponse) ProtoMessage() {}
 
 func (x *UserAvatarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[8]
+	mi := &file_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +845,7 @@ func (x *UserAvatarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAvatarResponse.ProtoReflect.Descriptor instead.
 func (*UserAvatarResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{8}
+	return file_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserAvatarResponse) GetAvatarUrl() string {
@@ -673,74 +855,2814 @@ func (x *UserAvatarResponse) GetAvatarUrl() string {
 	return ""
 }
 
-var File_user_proto protoreflect.FileDescriptor
+func (x *UserAvatarResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
 
-const file_user_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"user.proto\x12\x04user\"O\n" +
-	"\x15VerifyPasswordRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"u\n" +
-	"\x16VerifyPasswordResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05roles\x18\x04 \x03(\tR\x05roles\"]\n" +
-	"\x0fRegisterRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\x05R\x04type\"&\n" +
-	"\x10RegisterResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\"W\n" +
-	"\x0fUserDataRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
-	"\tuser_info\x18\x02 \x01(\v2\x0e.user.UserInfoR\buserInfo\"\xd0\x02\n" +
-	"\bUserInfo\x12\x1a\n" +
-	"\bnickname\x18\x01 \x01(\tR\bnickname\x12\x16\n" +
-	"\x06avatar\x18\x02 \x01(\tR\x06avatar\x12\x16\n" +
-	"\x06gender\x18\x03 \x01(\x05R\x06gender\x12\x1a\n" +
-	"\bbirthday\x18\x04 \x01(\tR\bbirthday\x12\x10\n" +
-	"\x03bio\x18\x05 \x01(\tR\x03bio\x12)\n" +
-	"\x10background_image\x18\x06 \x01(\tR\x0fbackgroundImage\x12\x18\n" +
-	"\acountry\x18\a \x01(\tR\acountry\x12\x1a\n" +
-	"\bprovince\x18\b \x01(\tR\bprovince\x12\x12\n" +
-	"\x04city\x18\t \x01(\tR\x04city\x12\x16\n" +
-	"\x06school\x18\n" +
-	" \x01(\tR\x06school\x12\x14\n" +
-	"\x05major\x18\v \x01(\tR\x05major\x12'\n" +
-	"\x0fgraduation_year\x18\f \x01(\x05R\x0egraduationYear\"\xaf\x03\n" +
-	"\x10UserDataResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
-	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x16\n" +
-	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x16\n" +
-	"\x06gender\x18\x04 \x01(\x05R\x06gender\x12\x1a\n" +
-	"\bbirthday\x18\x05 \x01(\tR\bbirthday\x12\x10\n" +
-	"\x03bio\x18\x06 \x01(\tR\x03bio\x12)\n" +
-	"\x10background_image\x18\a \x01(\tR\x0fbackgroundImage\x12\x18\n" +
-	"\acountry\x18\b \x01(\tR\acountry\x12\x1a\n" +
-	"\bprovince\x18\t \x01(\tR\bprovince\x12\x12\n" +
-	"\x04city\x18\n" +
-	" \x01(\tR\x04city\x12\x16\n" +
-	"\x06school\x18\v \x01(\tR\x06school\x12\x14\n" +
-	"\x05major\x18\f \x01(\tR\x05major\x12'\n" +
-	"\x0fgraduation_year\x18\r \x01(\x05R\x0egraduationYear\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x0f \x01(\tR\tupdatedAt\",\n" +
-	"\x11UserAvatarRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"3\n" +
-	"\x12UserAvatarResponse\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x01 \x01(\tR\tavatarUrl2\x99\x03\n" +
-	"\vUserService\x12K\n" +
-	"\x0eVerifyPassword\x12\x1b.user.VerifyPasswordRequest\x1a\x1c.user.VerifyPasswordResponse\x129\n" +
-	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\x12<\n" +
-	"\vGetUserData\x12\x15.user.UserDataRequest\x1a\x16.user.UserDataResponse\x12<\n" +
-	"\vSetUserData\x12\x15.user.UserDataRequest\x1a\x16.user.UserDataResponse\x12B\n" +
-	"\rGetUserAvatar\x12\x17.user.UserAvatarRequest\x1a\x18.user.UserAvatarResponse\x12B\n" +
-	"\rSetUserAvatar\x12\x17.user.UserAvatarRequest\x1a\x18.user.UserAvatarResponseBQ\n" +
+func (x *UserAvatarResponse) GetFormFields() map[string]string {
+	if x != nil {
+		return x.FormFields
+	}
+	return nil
+}
+
+func (x *UserAvatarResponse) GetProcessing() bool {
+	if x != nil {
+		return x.Processing
+	}
+	return false
+}
+
+type UserMediaRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// slot is the image to work on: "avatar" or "background". Required.
+	Slot string `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// object_key is the key returned by SetUserMedia; required by ConfirmUserMedia.
+	ObjectKey string `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// content_type and size describe the file about to be uploaded; required by SetUserMedia.
+	ContentType   string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserMediaRequest) Reset() {
+	*x = UserMediaRequest{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMediaRequest) ProtoMessage() {}
+
+func (x *UserMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMediaRequest.ProtoReflect.Descriptor instead.
+func (*UserMediaRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UserMediaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserMediaRequest) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+func (x *UserMediaRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *UserMediaRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UserMediaRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type UserMediaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// upload_url is set by SetUserMedia: POST the form_fields to it, followed by the file as the
+	// "file" part.
+	UploadUrl  string            `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	ObjectKey  string            `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	FormFields map[string]string `protobuf:"bytes,3,rep,name=form_fields,json=formFields,proto3" json:"form_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// processing is set by ConfirmUserMedia once the upload is queued. The current image stays in
+	// place until the processed one replaces it.
+	Processing    bool `protobuf:"varint,4,opt,name=processing,proto3" json:"processing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserMediaResponse) Reset() {
+	*x = UserMediaResponse{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMediaResponse) ProtoMessage() {}
+
+func (x *UserMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMediaResponse.ProtoReflect.Descriptor instead.
+func (*UserMediaResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserMediaResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *UserMediaResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *UserMediaResponse) GetFormFields() map[string]string {
+	if x != nil {
+		return x.FormFields
+	}
+	return nil
+}
+
+func (x *UserMediaResponse) GetProcessing() bool {
+	if x != nil {
+		return x.Processing
+	}
+	return false
+}
+
+type IdentifierRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// auth_type: 1-phone, 2-email.
+	AuthType      int32  `protobuf:"varint,2,opt,name=auth_type,json=authType,proto3" json:"auth_type,omitempty"`
+	Identifier    string `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Code          string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentifierRequest) Reset() {
+	*x = IdentifierRequest{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentifierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentifierRequest) ProtoMessage() {}
+
+func (x *IdentifierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentifierRequest.ProtoReflect.Descriptor instead.
+func (*IdentifierRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IdentifierRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IdentifierRequest) GetAuthType() int32 {
+	if x != nil {
+		return x.AuthType
+	}
+	return 0
+}
+
+func (x *IdentifierRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *IdentifierRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type IdentifierResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AuthType   int32                  `protobuf:"varint,1,opt,name=auth_type,json=authType,proto3" json:"auth_type,omitempty"`
+	Identifier string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Verified   bool                   `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	// code_expires_in is set by BindIdentifier: seconds until the sent code expires.
+	CodeExpiresIn int64 `protobuf:"varint,4,opt,name=code_expires_in,json=codeExpiresIn,proto3" json:"code_expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentifierResponse) Reset() {
+	*x = IdentifierResponse{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentifierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentifierResponse) ProtoMessage() {}
+
+func (x *IdentifierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentifierResponse.ProtoReflect.Descriptor instead.
+func (*IdentifierResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IdentifierResponse) GetAuthType() int32 {
+	if x != nil {
+		return x.AuthType
+	}
+	return 0
+}
+
+func (x *IdentifierResponse) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *IdentifierResponse) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *IdentifierResponse) GetCodeExpiresIn() int64 {
+	if x != nil {
+		return x.CodeExpiresIn
+	}
+	return 0
+}
+
+// OAuthIdentity is what a third-party provider returned for the signed-in account.
+type OAuthIdentity struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Platform     string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Openid       string                 `protobuf:"bytes,2,opt,name=openid,proto3" json:"openid,omitempty"`
+	Unionid      string                 `protobuf:"bytes,3,opt,name=unionid,proto3" json:"unionid,omitempty"`
+	Nickname     string                 `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar       string                 `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	AccessToken  string                 `protobuf:"bytes,6,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string                 `protobuf:"bytes,7,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// token_expires_at is a unix timestamp in seconds, 0 when unknown.
+	TokenExpiresAt int64 `protobuf:"varint,8,opt,name=token_expires_at,json=tokenExpiresAt,proto3" json:"token_expires_at,omitempty"`
+	// raw_data is the provider's user payload as JSON.
+	RawData       string `protobuf:"bytes,9,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthIdentity) Reset() {
+	*x = OAuthIdentity{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthIdentity) ProtoMessage() {}
+
+func (x *OAuthIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthIdentity.ProtoReflect.Descriptor instead.
+func (*OAuthIdentity) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OAuthIdentity) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *OAuthIdentity) GetOpenid() string {
+	if x != nil {
+		return x.Openid
+	}
+	return ""
+}
+
+func (x *OAuthIdentity) GetUnionid() string {
+	if x != nil {
+		return x.Unionid
+	}
+	return ""
+}
+
+func (x *OAuthIdentity) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *OAuthIdentity) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *OAuthIdentity) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *OAuthIdentity) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *OAuthIdentity) GetTokenExpiresAt() int64 {
+	if x != nil {
+		return x.TokenExpiresAt
+	}
+	return 0
+}
+
+func (x *OAuthIdentity) GetRawData() string {
+	if x != nil {
+		return x.RawData
+	}
+	return ""
+}
+
+type OAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Identity      *OAuthIdentity         `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthRequest) Reset() {
+	*x = OAuthRequest{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthRequest) ProtoMessage() {}
+
+func (x *OAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthRequest.ProtoReflect.Descriptor instead.
+func (*OAuthRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OAuthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OAuthRequest) GetIdentity() *OAuthIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type OAuthLoginResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Roles    []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	// created is true when this login created the account.
+	Created       bool `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthLoginResponse) Reset() {
+	*x = OAuthLoginResponse{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthLoginResponse) ProtoMessage() {}
+
+func (x *OAuthLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthLoginResponse.ProtoReflect.Descriptor instead.
+func (*OAuthLoginResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OAuthLoginResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OAuthLoginResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *OAuthLoginResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *OAuthLoginResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type OAuthBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthBinding) Reset() {
+	*x = OAuthBinding{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthBinding) ProtoMessage() {}
+
+func (x *OAuthBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthBinding.ProtoReflect.Descriptor instead.
+func (*OAuthBinding) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OAuthBinding) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *OAuthBinding) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *OAuthBinding) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *OAuthBinding) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type OAuthBindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bindings      []*OAuthBinding        `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthBindingsResponse) Reset() {
+	*x = OAuthBindingsResponse{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthBindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthBindingsResponse) ProtoMessage() {}
+
+func (x *OAuthBindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthBindingsResponse.ProtoReflect.Descriptor instead.
+func (*OAuthBindingsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OAuthBindingsResponse) GetBindings() []*OAuthBinding {
+	if x != nil {
+		return x.Bindings
+	}
+	return nil
+}
+
+// UserSettings mirrors t_user_settings. On update, unset fields are left unchanged.
+type UserSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// privacy_level: 0-private, 1-public, 2-followers only.
+	PrivacyLevel     *int32 `protobuf:"varint,1,opt,name=privacy_level,json=privacyLevel,proto3,oneof" json:"privacy_level,omitempty"`
+	AllowStrangerMsg *bool  `protobuf:"varint,2,opt,name=allow_stranger_msg,json=allowStrangerMsg,proto3,oneof" json:"allow_stranger_msg,omitempty"`
+	ShowOnlineStatus *bool  `protobuf:"varint,3,opt,name=show_online_status,json=showOnlineStatus,proto3,oneof" json:"show_online_status,omitempty"`
+	ShowLikes        *bool  `protobuf:"varint,4,opt,name=show_likes,json=showLikes,proto3,oneof" json:"show_likes,omitempty"`
+	ShowFollowing    *bool  `protobuf:"varint,5,opt,name=show_following,json=showFollowing,proto3,oneof" json:"show_following,omitempty"`
+	ShowFollowers    *bool  `protobuf:"varint,6,opt,name=show_followers,json=showFollowers,proto3,oneof" json:"show_followers,omitempty"`
+	// notification_settings is a JSON object with the push/email/sms/quiet_hours groups.
+	// On update it is merged into the stored object; null or missing keys keep their value.
+	NotificationSettings string  `protobuf:"bytes,7,opt,name=notification_settings,json=notificationSettings,proto3" json:"notification_settings,omitempty"`
+	Language             *string `protobuf:"bytes,8,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	Timezone             *string `protobuf:"bytes,9,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	// theme: auto, light or dark.
+	Theme         *string `protobuf:"bytes,10,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
+	UpdatedAt     string  `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserSettings) GetPrivacyLevel() int32 {
+	if x != nil && x.PrivacyLevel != nil {
+		return *x.PrivacyLevel
+	}
+	return 0
+}
+
+func (x *UserSettings) GetAllowStrangerMsg() bool {
+	if x != nil && x.AllowStrangerMsg != nil {
+		return *x.AllowStrangerMsg
+	}
+	return false
+}
+
+func (x *UserSettings) GetShowOnlineStatus() bool {
+	if x != nil && x.ShowOnlineStatus != nil {
+		return *x.ShowOnlineStatus
+	}
+	return false
+}
+
+func (x *UserSettings) GetShowLikes() bool {
+	if x != nil && x.ShowLikes != nil {
+		return *x.ShowLikes
+	}
+	return false
+}
+
+func (x *UserSettings) GetShowFollowing() bool {
+	if x != nil && x.ShowFollowing != nil {
+		return *x.ShowFollowing
+	}
+	return false
+}
+
+func (x *UserSettings) GetShowFollowers() bool {
+	if x != nil && x.ShowFollowers != nil {
+		return *x.ShowFollowers
+	}
+	return false
+}
+
+func (x *UserSettings) GetNotificationSettings() string {
+	if x != nil {
+		return x.NotificationSettings
+	}
+	return ""
+}
+
+func (x *UserSettings) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *UserSettings) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UserSettings) GetTheme() string {
+	if x != nil && x.Theme != nil {
+		return *x.Theme
+	}
+	return ""
+}
+
+func (x *UserSettings) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UserSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Settings      *UserSettings          `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSettingsRequest) Reset() {
+	*x = UserSettingsRequest{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsRequest) ProtoMessage() {}
+
+func (x *UserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UserSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSettingsRequest) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UserSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSettingsResponse) Reset() {
+	*x = UserSettingsResponse{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsResponse) ProtoMessage() {}
+
+func (x *UserSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UserSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type FollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequest) ProtoMessage() {}
+
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FollowRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FollowRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type FollowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// following is true when user_id follows target_user_id.
+	Following bool `protobuf:"varint,1,opt,name=following,proto3" json:"following,omitempty"`
+	// followed_by is true when target_user_id follows user_id. Only set by IsFollowing.
+	FollowedBy    bool `protobuf:"varint,2,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowResponse) Reset() {
+	*x = FollowResponse{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowResponse) ProtoMessage() {}
+
+func (x *FollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
+func (*FollowResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FollowResponse) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *FollowResponse) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+type FollowListRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// cursor is the next_cursor of the previous page, empty for the first page.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// limit defaults to 20 and is capped at 100.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// viewer_id is the signed-in caller, empty for anonymous viewers. Owners always see their own lists.
+	ViewerId      string `protobuf:"bytes,4,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowListRequest) Reset() {
+	*x = FollowListRequest{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowListRequest) ProtoMessage() {}
+
+func (x *FollowListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowListRequest.ProtoReflect.Descriptor instead.
+func (*FollowListRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FollowListRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FollowListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *FollowListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FollowListRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type FollowUser struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// avatar is a presigned URL of the small avatar variant.
+	Avatar        string `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	FollowedAt    string `protobuf:"bytes,4,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUser) Reset() {
+	*x = FollowUser{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUser) ProtoMessage() {}
+
+func (x *FollowUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUser.ProtoReflect.Descriptor instead.
+func (*FollowUser) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FollowUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FollowUser) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *FollowUser) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *FollowUser) GetFollowedAt() string {
+	if x != nil {
+		return x.FollowedAt
+	}
+	return ""
+}
+
+type FollowListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*FollowUser          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowListResponse) Reset() {
+	*x = FollowListResponse{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowListResponse) ProtoMessage() {}
+
+func (x *FollowListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowListResponse.ProtoReflect.Descriptor instead.
+func (*FollowListResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FollowListResponse) GetUsers() []*FollowUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *FollowListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PublicProfileRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// viewer_id is the signed-in caller, empty for anonymous viewers.
+	ViewerId      string `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicProfileRequest) Reset() {
+	*x = PublicProfileRequest{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfileRequest) ProtoMessage() {}
+
+func (x *PublicProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfileRequest.ProtoReflect.Descriptor instead.
+func (*PublicProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PublicProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PublicProfileRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type UserStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FollowingCount int64                  `protobuf:"varint,1,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	FollowerCount  int64                  `protobuf:"varint,2,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	LikesCount     int64                  `protobuf:"varint,3,opt,name=likes_count,json=likesCount,proto3" json:"likes_count,omitempty"`
+	VideoCount     int64                  `protobuf:"varint,4,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserStats) Reset() {
+	*x = UserStats{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStats) ProtoMessage() {}
+
+func (x *UserStats) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
+func (*UserStats) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UserStats) GetFollowingCount() int64 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+func (x *UserStats) GetFollowerCount() int64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *UserStats) GetLikesCount() int64 {
+	if x != nil {
+		return x.LikesCount
+	}
+	return 0
+}
+
+func (x *UserStats) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+// PublicProfileResponse never carries birthday or graduation year. When restricted is true only
+// user_id, nickname, avatar_url and the relationship flags are filled.
+type PublicProfileResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname           string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl          string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Gender             int32                  `protobuf:"varint,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	Bio                string                 `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	BackgroundImageUrl string                 `protobuf:"bytes,6,opt,name=background_image_url,json=backgroundImageUrl,proto3" json:"background_image_url,omitempty"`
+	Country            string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Province           string                 `protobuf:"bytes,8,opt,name=province,proto3" json:"province,omitempty"`
+	City               string                 `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
+	School             string                 `protobuf:"bytes,10,opt,name=school,proto3" json:"school,omitempty"`
+	Major              string                 `protobuf:"bytes,11,opt,name=major,proto3" json:"major,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Stats              *UserStats             `protobuf:"bytes,13,opt,name=stats,proto3" json:"stats,omitempty"`
+	Following          bool                   `protobuf:"varint,14,opt,name=following,proto3" json:"following,omitempty"`
+	FollowedBy         bool                   `protobuf:"varint,15,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	Restricted         bool                   `protobuf:"varint,16,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PublicProfileResponse) Reset() {
+	*x = PublicProfileResponse{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfileResponse) ProtoMessage() {}
+
+func (x *PublicProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfileResponse.ProtoReflect.Descriptor instead.
+func (*PublicProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PublicProfileResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *PublicProfileResponse) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetBackgroundImageUrl() string {
+	if x != nil {
+		return x.BackgroundImageUrl
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetSchool() string {
+	if x != nil {
+		return x.School
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetMajor() string {
+	if x != nil {
+		return x.Major
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetStats() *UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *PublicProfileResponse) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *PublicProfileResponse) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+func (x *PublicProfileResponse) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+type BatchGetUserCardsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserIds []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// avatar_size picks the avatar variant, as in UserAvatarRequest.
+	AvatarSize    int32 `protobuf:"varint,2,opt,name=avatar_size,json=avatarSize,proto3" json:"avatar_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUserCardsRequest) Reset() {
+	*x = BatchGetUserCardsRequest{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUserCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUserCardsRequest) ProtoMessage() {}
+
+func (x *BatchGetUserCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUserCardsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUserCardsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchGetUserCardsRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BatchGetUserCardsRequest) GetAvatarSize() int32 {
+	if x != nil {
+		return x.AvatarSize
+	}
+	return 0
+}
+
+type UserCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCard) Reset() {
+	*x = UserCard{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCard) ProtoMessage() {}
+
+func (x *UserCard) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCard.ProtoReflect.Descriptor instead.
+func (*UserCard) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UserCard) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserCard) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UserCard) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type BatchGetUserCardsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cards follow the order of user_ids, one per found id, repeated ids included.
+	Cards []*UserCard `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	// missing_user_ids lists ids that are malformed, unknown or deleted, each once.
+	MissingUserIds []string `protobuf:"bytes,2,rep,name=missing_user_ids,json=missingUserIds,proto3" json:"missing_user_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchGetUserCardsResponse) Reset() {
+	*x = BatchGetUserCardsResponse{}
+	mi := &file_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUserCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUserCardsResponse) ProtoMessage() {}
+
+func (x *BatchGetUserCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUserCardsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUserCardsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchGetUserCardsResponse) GetCards() []*UserCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *BatchGetUserCardsResponse) GetMissingUserIds() []string {
+	if x != nil {
+		return x.MissingUserIds
+	}
+	return nil
+}
+
+type DeactivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountRequest) Reset() {
+	*x = DeactivateAccountRequest{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountRequest) ProtoMessage() {}
+
+func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeactivateAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeactivateAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// purge_after is when the account data is erased unless it is reactivated first (RFC 3339).
+	PurgeAfter    string `protobuf:"bytes,1,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAccountResponse) Reset() {
+	*x = DeactivateAccountResponse{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAccountResponse) ProtoMessage() {}
+
+func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeactivateAccountResponse) GetPurgeAfter() string {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return ""
+}
+
+type ReactivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAccountRequest) Reset() {
+	*x = ReactivateAccountRequest{}
+	mi := &file_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAccountRequest) ProtoMessage() {}
+
+func (x *ReactivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReactivateAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReactivateAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ReactivateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAccountResponse) Reset() {
+	*x = ReactivateAccountResponse{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAccountResponse) ProtoMessage() {}
+
+func (x *ReactivateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReactivateAccountResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReactivateAccountResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReactivateAccountResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChangePasswordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type PasswordResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is the account whose password changed; its existing sessions should be revoked.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordResponse) Reset() {
+	*x = PasswordResponse{}
+	mi := &file_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordResponse) ProtoMessage() {}
+
+func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordResponse.ProtoReflect.Descriptor instead.
+func (*PasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PasswordResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// login is a username, a verified email or a verified phone number.
+	Login         string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordResetRequest) Reset() {
+	*x = PasswordResetRequest{}
+	mi := &file_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordResetRequest) ProtoMessage() {}
+
+func (x *PasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*PasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PasswordResetRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type PasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordResetResponse) Reset() {
+	*x = PasswordResetResponse{}
+	mi := &file_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordResetResponse) ProtoMessage() {}
+
+func (x *PasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*PasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{41}
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type AdminUser struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// status: 0-disabled, 1-active, 2-pending, 3-deactivated.
+	Status    int32  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// deleted_at is set once the account was deactivated.
+	DeletedAt     string `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Purged        bool   `protobuf:"varint,7,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	mi := &file_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUser.ProtoReflect.Descriptor instead.
+func (*AdminUser) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AdminUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminUser) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AdminUser) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminUser) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUser) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *AdminUser) GetPurged() bool {
+	if x != nil {
+		return x.Purged
+	}
+	return false
+}
+
+type AdminSearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// username and nickname match by prefix.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// statuses keeps accounts in any of the listed states; empty matches all.
+	Statuses []int32 `protobuf:"varint,3,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+	// created_after and created_before bound created_at (RFC 3339, inclusive / exclusive).
+	CreatedAfter  string `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore string `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// cursor is the next_cursor of the previous page, empty for the first page.
+	Cursor        string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSearchUsersRequest) Reset() {
+	*x = AdminSearchUsersRequest{}
+	mi := &file_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchUsersRequest) ProtoMessage() {}
+
+func (x *AdminSearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*AdminSearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AdminSearchUsersRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminSearchUsersRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AdminSearchUsersRequest) GetStatuses() []int32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *AdminSearchUsersRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *AdminSearchUsersRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *AdminSearchUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminSearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminSearchUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*AdminUser           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// next_cursor is empty on the last page.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSearchUsersResponse) Reset() {
+	*x = AdminSearchUsersResponse{}
+	mi := &file_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchUsersResponse) ProtoMessage() {}
+
+func (x *AdminSearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*AdminSearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AdminSearchUsersResponse) GetUsers() []*AdminUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AdminSearchUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type AdminSetUserStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// status is 0 to disable the account or 1 to enable it.
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// operator_id is the administrator making the change, recorded in the log.
+	OperatorId    string `protobuf:"bytes,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetUserStatusRequest) Reset() {
+	*x = AdminSetUserStatusRequest{}
+	mi := &file_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetUserStatusRequest) ProtoMessage() {}
+
+func (x *AdminSetUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AdminSetUserStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminSetUserStatusRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminSetUserStatusRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type AdminUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUserRequest) Reset() {
+	*x = AdminUserRequest{}
+	mi := &file_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRequest) ProtoMessage() {}
+
+func (x *AdminUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AdminUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AdminUserBindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*IdentifierResponse  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	OauthBindings []*OAuthBinding        `protobuf:"bytes,2,rep,name=oauth_bindings,json=oauthBindings,proto3" json:"oauth_bindings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUserBindingsResponse) Reset() {
+	*x = AdminUserBindingsResponse{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUserBindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserBindingsResponse) ProtoMessage() {}
+
+func (x *AdminUserBindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserBindingsResponse.ProtoReflect.Descriptor instead.
+func (*AdminUserBindingsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AdminUserBindingsResponse) GetIdentifiers() []*IdentifierResponse {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *AdminUserBindingsResponse) GetOauthBindings() []*OAuthBinding {
+	if x != nil {
+		return x.OauthBindings
+	}
+	return nil
+}
+
+var File_user_proto protoreflect.FileDescriptor
+
+const file_user_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"user.proto\x12\x04user\"O\n" +
+	"\x15VerifyPasswordRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"u\n" +
+	"\x16VerifyPasswordResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\"]\n" +
+	"\x0fRegisterRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\x05R\x04type\"&\n" +
+	"\x10RegisterResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\"W\n" +
+	"\x0fUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\tuser_info\x18\x02 \x01(\v2\x0e.user.UserInfoR\buserInfo\"\xd0\x02\n" +
+	"\bUserInfo\x12\x1a\n" +
+	"\bnickname\x18\x01 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06avatar\x18\x02 \x01(\tR\x06avatar\x12\x16\n" +
+	"\x06gender\x18\x03 \x01(\x05R\x06gender\x12\x1a\n" +
+	"\bbirthday\x18\x04 \x01(\tR\bbirthday\x12\x10\n" +
+	"\x03bio\x18\x05 \x01(\tR\x03bio\x12)\n" +
+	"\x10background_image\x18\x06 \x01(\tR\x0fbackgroundImage\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12\x1a\n" +
+	"\bprovince\x18\b \x01(\tR\bprovince\x12\x12\n" +
+	"\x04city\x18\t \x01(\tR\x04city\x12\x16\n" +
+	"\x06school\x18\n" +
+	" \x01(\tR\x06school\x12\x14\n" +
+	"\x05major\x18\v \x01(\tR\x05major\x12'\n" +
+	"\x0fgraduation_year\x18\f \x01(\x05R\x0egraduationYear\"\x80\x04\n" +
+	"\x10UserDataResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x16\n" +
+	"\x06gender\x18\x04 \x01(\x05R\x06gender\x12\x1a\n" +
+	"\bbirthday\x18\x05 \x01(\tR\bbirthday\x12\x10\n" +
+	"\x03bio\x18\x06 \x01(\tR\x03bio\x12)\n" +
+	"\x10background_image\x18\a \x01(\tR\x0fbackgroundImage\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\x12\x1a\n" +
+	"\bprovince\x18\t \x01(\tR\bprovince\x12\x12\n" +
+	"\x04city\x18\n" +
+	" \x01(\tR\x04city\x12\x16\n" +
+	"\x06school\x18\v \x01(\tR\x06school\x12\x14\n" +
+	"\x05major\x18\f \x01(\tR\x05major\x12'\n" +
+	"\x0fgraduation_year\x18\r \x01(\x05R\x0egraduationYear\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x10 \x01(\tR\tavatarUrl\x120\n" +
+	"\x14background_image_url\x18\x11 \x01(\tR\x12backgroundImageUrl\"+\n" +
+	"\x10UserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"^\n" +
+	"\x11UserRolesResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\"\xb7\x01\n" +
+	"\x11UserAvatarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tR\tobjectKey\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1f\n" +
+	"\vavatar_size\x18\x05 \x01(\x05R\n" +
+	"avatarSize\x12\x12\n" +
+	"\x04slot\x18\x06 \x01(\tR\x04slot\"\xfc\x01\n" +
+	"\x12UserAvatarResponse\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x01 \x01(\tR\tavatarUrl\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tR\tobjectKey\x12I\n" +
+	"\vform_fields\x18\x03 \x03(\v2(.user.UserAvatarResponse.FormFieldsEntryR\n" +
+	"formFields\x12\x1e\n" +
+	"\n" +
+	"processing\x18\x04 \x01(\bR\n" +
+	"processing\x1a=\n" +
+	"\x0fFormFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x95\x01\n" +
+	"\x10UserMediaRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\tR\x04slot\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\"\xfa\x01\n" +
+	"\x11UserMediaResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tR\tobjectKey\x12H\n" +
+	"\vform_fields\x18\x03 \x03(\v2'.user.UserMediaResponse.FormFieldsEntryR\n" +
+	"formFields\x12\x1e\n" +
+	"\n" +
+	"processing\x18\x04 \x01(\bR\n" +
+	"processing\x1a=\n" +
+	"\x0fFormFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"}\n" +
+	"\x11IdentifierRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tauth_type\x18\x02 \x01(\x05R\bauthType\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x03 \x01(\tR\n" +
+	"identifier\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\"\x95\x01\n" +
+	"\x12IdentifierResponse\x12\x1b\n" +
+	"\tauth_type\x18\x01 \x01(\x05R\bauthType\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\tR\n" +
+	"identifier\x12\x1a\n" +
+	"\bverified\x18\x03 \x01(\bR\bverified\x12&\n" +
+	"\x0fcode_expires_in\x18\x04 \x01(\x03R\rcodeExpiresIn\"\x9e\x02\n" +
+	"\rOAuthIdentity\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x16\n" +
+	"\x06openid\x18\x02 \x01(\tR\x06openid\x12\x18\n" +
+	"\aunionid\x18\x03 \x01(\tR\aunionid\x12\x1a\n" +
+	"\bnickname\x18\x04 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06avatar\x18\x05 \x01(\tR\x06avatar\x12!\n" +
+	"\faccess_token\x18\x06 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\a \x01(\tR\frefreshToken\x12(\n" +
+	"\x10token_expires_at\x18\b \x01(\x03R\x0etokenExpiresAt\x12\x19\n" +
+	"\braw_data\x18\t \x01(\tR\arawData\"X\n" +
+	"\fOAuthRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\bidentity\x18\x02 \x01(\v2\x13.user.OAuthIdentityR\bidentity\"y\n" +
+	"\x12OAuthLoginResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\bR\acreated\"}\n" +
+	"\fOAuthBinding\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"G\n" +
+	"\x15OAuthBindingsResponse\x12.\n" +
+	"\bbindings\x18\x01 \x03(\v2\x12.user.OAuthBindingR\bbindings\"\xe4\x04\n" +
+	"\fUserSettings\x12(\n" +
+	"\rprivacy_level\x18\x01 \x01(\x05H\x00R\fprivacyLevel\x88\x01\x01\x121\n" +
+	"\x12allow_stranger_msg\x18\x02 \x01(\bH\x01R\x10allowStrangerMsg\x88\x01\x01\x121\n" +
+	"\x12show_online_status\x18\x03 \x01(\bH\x02R\x10showOnlineStatus\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"show_likes\x18\x04 \x01(\bH\x03R\tshowLikes\x88\x01\x01\x12*\n" +
+	"\x0eshow_following\x18\x05 \x01(\bH\x04R\rshowFollowing\x88\x01\x01\x12*\n" +
+	"\x0eshow_followers\x18\x06 \x01(\bH\x05R\rshowFollowers\x88\x01\x01\x123\n" +
+	"\x15notification_settings\x18\a \x01(\tR\x14notificationSettings\x12\x1f\n" +
+	"\blanguage\x18\b \x01(\tH\x06R\blanguage\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\t \x01(\tH\aR\btimezone\x88\x01\x01\x12\x19\n" +
+	"\x05theme\x18\n" +
+	" \x01(\tH\bR\x05theme\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAtB\x10\n" +
+	"\x0e_privacy_levelB\x15\n" +
+	"\x13_allow_stranger_msgB\x15\n" +
+	"\x13_show_online_statusB\r\n" +
+	"\v_show_likesB\x11\n" +
+	"\x0f_show_followingB\x11\n" +
+	"\x0f_show_followersB\v\n" +
+	"\t_languageB\v\n" +
+	"\t_timezoneB\b\n" +
+	"\x06_theme\"^\n" +
+	"\x13UserSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\bsettings\x18\x02 \x01(\v2\x12.user.UserSettingsR\bsettings\"F\n" +
+	"\x14UserSettingsResponse\x12.\n" +
+	"\bsettings\x18\x01 \x01(\v2\x12.user.UserSettingsR\bsettings\"N\n" +
+	"\rFollowRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\tR\ftargetUserId\"O\n" +
+	"\x0eFollowResponse\x12\x1c\n" +
+	"\tfollowing\x18\x01 \x01(\bR\tfollowing\x12\x1f\n" +
+	"\vfollowed_by\x18\x02 \x01(\bR\n" +
+	"followedBy\"w\n" +
+	"\x11FollowListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\tviewer_id\x18\x04 \x01(\tR\bviewerId\"z\n" +
+	"\n" +
+	"FollowUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x1f\n" +
+	"\vfollowed_at\x18\x04 \x01(\tR\n" +
+	"followedAt\"]\n" +
+	"\x12FollowListResponse\x12&\n" +
+	"\x05users\x18\x01 \x03(\v2\x10.user.FollowUserR\x05users\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"L\n" +
+	"\x14PublicProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"\x9d\x01\n" +
+	"\tUserStats\x12'\n" +
+	"\x0ffollowing_count\x18\x01 \x01(\x03R\x0efollowingCount\x12%\n" +
+	"\x0efollower_count\x18\x02 \x01(\x03R\rfollowerCount\x12\x1f\n" +
+	"\vlikes_count\x18\x03 \x01(\x03R\n" +
+	"likesCount\x12\x1f\n" +
+	"\vvideo_count\x18\x04 \x01(\x03R\n" +
+	"videoCount\"\xe4\x03\n" +
+	"\x15PublicProfileResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x16\n" +
+	"\x06gender\x18\x04 \x01(\x05R\x06gender\x12\x10\n" +
+	"\x03bio\x18\x05 \x01(\tR\x03bio\x120\n" +
+	"\x14background_image_url\x18\x06 \x01(\tR\x12backgroundImageUrl\x12\x18\n" +
+	"\acountry\x18\a \x01(\tR\acountry\x12\x1a\n" +
+	"\bprovince\x18\b \x01(\tR\bprovince\x12\x12\n" +
+	"\x04city\x18\t \x01(\tR\x04city\x12\x16\n" +
+	"\x06school\x18\n" +
+	" \x01(\tR\x06school\x12\x14\n" +
+	"\x05major\x18\v \x01(\tR\x05major\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\x12%\n" +
+	"\x05stats\x18\r \x01(\v2\x0f.user.UserStatsR\x05stats\x12\x1c\n" +
+	"\tfollowing\x18\x0e \x01(\bR\tfollowing\x12\x1f\n" +
+	"\vfollowed_by\x18\x0f \x01(\bR\n" +
+	"followedBy\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\x10 \x01(\bR\n" +
+	"restricted\"V\n" +
+	"\x18BatchGetUserCardsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12\x1f\n" +
+	"\vavatar_size\x18\x02 \x01(\x05R\n" +
+	"avatarSize\"^\n" +
+	"\bUserCard\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\"k\n" +
+	"\x19BatchGetUserCardsResponse\x12$\n" +
+	"\x05cards\x18\x01 \x03(\v2\x0e.user.UserCardR\x05cards\x12(\n" +
+	"\x10missing_user_ids\x18\x02 \x03(\tR\x0emissingUserIds\"3\n" +
+	"\x18DeactivateAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"<\n" +
+	"\x19DeactivateAccountResponse\x12\x1f\n" +
+	"\vpurge_after\x18\x01 \x01(\tR\n" +
+	"purgeAfter\"R\n" +
+	"\x18ReactivateAccountRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"f\n" +
+	"\x19ReactivateAccountResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\"v\n" +
+	"\x15ChangePasswordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"+\n" +
+	"\x10PasswordResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\",\n" +
+	"\x14PasswordResetRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\"\x17\n" +
+	"\x15PasswordResetResponse\"O\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"\xca\x01\n" +
+	"\tAdminUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\tR\tdeletedAt\x12\x16\n" +
+	"\x06purged\x18\a \x01(\bR\x06purged\"\xe7\x01\n" +
+	"\x17AdminSearchUsersRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\x05R\bstatuses\x12#\n" +
+	"\rcreated_after\x18\x04 \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x05 \x01(\tR\rcreatedBefore\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"b\n" +
+	"\x18AdminSearchUsersResponse\x12%\n" +
+	"\x05users\x18\x01 \x03(\v2\x0f.user.AdminUserR\x05users\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"m\n" +
+	"\x19AdminSetUserStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\tR\n" +
+	"operatorId\"+\n" +
+	"\x10AdminUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x92\x01\n" +
+	"\x19AdminUserBindingsResponse\x12:\n" +
+	"\videntifiers\x18\x01 \x03(\v2\x18.user.IdentifierResponseR\videntifiers\x129\n" +
+	"\x0eoauth_bindings\x18\x02 \x03(\v2\x12.user.OAuthBindingR\roauthBindings2\xd8\x12\n" +
+	"\vUserService\x12K\n" +
+	"\x0eVerifyPassword\x12\x1b.user.VerifyPasswordRequest\x1a\x1c.user.VerifyPasswordResponse\x129\n" +
+	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\x12<\n" +
+	"\vGetUserData\x12\x15.user.UserDataRequest\x1a\x16.user.UserDataResponse\x12<\n" +
+	"\vSetUserData\x12\x15.user.UserDataRequest\x1a\x16.user.UserDataResponse\x12?\n" +
+	"\fGetUserRoles\x12\x16.user.UserRolesRequest\x1a\x17.user.UserRolesResponse\x12B\n" +
+	"\rGetUserAvatar\x12\x17.user.UserAvatarRequest\x1a\x18.user.UserAvatarResponse\x12B\n" +
+	"\rSetUserAvatar\x12\x17.user.UserAvatarRequest\x1a\x18.user.UserAvatarResponse\x12F\n" +
+	"\x11ConfirmUserAvatar\x12\x17.user.UserAvatarRequest\x1a\x18.user.UserAvatarResponse\x12?\n" +
+	"\fSetUserMedia\x12\x16.user.UserMediaRequest\x1a\x17.user.UserMediaResponse\x12C\n" +
+	"\x10ConfirmUserMedia\x12\x16.user.UserMediaRequest\x1a\x17.user.UserMediaResponse\x12C\n" +
+	"\x0eBindIdentifier\x12\x17.user.IdentifierRequest\x1a\x18.user.IdentifierResponse\x12E\n" +
+	"\x10VerifyIdentifier\x12\x17.user.IdentifierRequest\x1a\x18.user.IdentifierResponse\x12E\n" +
+	"\x10UnbindIdentifier\x12\x17.user.IdentifierRequest\x1a\x18.user.IdentifierResponse\x12:\n" +
+	"\n" +
+	"OAuthLogin\x12\x12.user.OAuthRequest\x1a\x18.user.OAuthLoginResponse\x12<\n" +
+	"\tBindOAuth\x12\x12.user.OAuthRequest\x1a\x1b.user.OAuthBindingsResponse\x12>\n" +
+	"\vUnbindOAuth\x12\x12.user.OAuthRequest\x1a\x1b.user.OAuthBindingsResponse\x12D\n" +
+	"\x11ListOAuthBindings\x12\x12.user.OAuthRequest\x1a\x1b.user.OAuthBindingsResponse\x12H\n" +
+	"\x0fGetUserSettings\x12\x19.user.UserSettingsRequest\x1a\x1a.user.UserSettingsResponse\x12K\n" +
+	"\x12UpdateUserSettings\x12\x19.user.UserSettingsRequest\x1a\x1a.user.UserSettingsResponse\x123\n" +
+	"\x06Follow\x12\x13.user.FollowRequest\x1a\x14.user.FollowResponse\x125\n" +
+	"\bUnfollow\x12\x13.user.FollowRequest\x1a\x14.user.FollowResponse\x128\n" +
+	"\vIsFollowing\x12\x13.user.FollowRequest\x1a\x14.user.FollowResponse\x12B\n" +
+	"\rListFollowers\x12\x17.user.FollowListRequest\x1a\x18.user.FollowListResponse\x12B\n" +
+	"\rListFollowing\x12\x17.user.FollowListRequest\x1a\x18.user.FollowListResponse\x12K\n" +
+	"\x10GetPublicProfile\x12\x1a.user.PublicProfileRequest\x1a\x1b.user.PublicProfileResponse\x12T\n" +
+	"\x11BatchGetUserCards\x12\x1e.user.BatchGetUserCardsRequest\x1a\x1f.user.BatchGetUserCardsResponse\x12T\n" +
+	"\x11DeactivateAccount\x12\x1e.user.DeactivateAccountRequest\x1a\x1f.user.DeactivateAccountResponse\x12T\n" +
+	"\x11ReactivateAccount\x12\x1e.user.ReactivateAccountRequest\x1a\x1f.user.ReactivateAccountResponse\x12E\n" +
+	"\x0eChangePassword\x12\x1b.user.ChangePasswordRequest\x1a\x16.user.PasswordResponse\x12O\n" +
+	"\x14RequestPasswordReset\x12\x1a.user.PasswordResetRequest\x1a\x1b.user.PasswordResetResponse\x12C\n" +
+	"\rResetPassword\x12\x1a.user.ResetPasswordRequest\x1a\x16.user.PasswordResponse\x12Q\n" +
+	"\x10AdminSearchUsers\x12\x1d.user.AdminSearchUsersRequest\x1a\x1e.user.AdminSearchUsersResponse\x12F\n" +
+	"\x12AdminSetUserStatus\x12\x1f.user.AdminSetUserStatusRequest\x1a\x0f.user.AdminUser\x12O\n" +
+	"\x14AdminGetUserBindings\x12\x16.user.AdminUserRequest\x1a\x1f.user.AdminUserBindingsResponseBQ\n" +
 	"\x16com.astraios.grpc.userP\x01Z5github.com/GUET-BAT/Astraios-S/user-service/pb/userpbb\x06proto3"
 
 var (
@@ -755,37 +3677,147 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_user_proto_goTypes = []any{
-	(*VerifyPasswordRequest)(nil),  // 0: user.VerifyPasswordRequest
-	(*VerifyPasswordResponse)(nil), // 1: user.VerifyPasswordResponse
-	(*RegisterRequest)(nil),        // 2: user.RegisterRequest
-	(*RegisterResponse)(nil),       // 3: user.RegisterResponse
-	(*UserDataRequest)(nil),        // 4: user.UserDataRequest
-	(*UserInfo)(nil),               // 5: user.UserInfo
-	(*UserDataResponse)(nil),       // 6: user.UserDataResponse
-	(*UserAvatarRequest)(nil),      // 7: user.UserAvatarRequest
-	(*UserAvatarResponse)(nil),     // 8: user.UserAvatarResponse
+	(*VerifyPasswordRequest)(nil),     // 0: user.VerifyPasswordRequest
+	(*VerifyPasswordResponse)(nil),    // 1: user.VerifyPasswordResponse
+	(*RegisterRequest)(nil),           // 2: user.RegisterRequest
+	(*RegisterResponse)(nil),          // 3: user.RegisterResponse
+	(*UserDataRequest)(nil),           // 4: user.UserDataRequest
+	(*UserInfo)(nil),                  // 5: user.UserInfo
+	(*UserDataResponse)(nil),          // 6: user.UserDataResponse
+	(*UserRolesRequest)(nil),          // 7: user.UserRolesRequest
+	(*UserRolesResponse)(nil),         // 8: user.UserRolesResponse
+	(*UserAvatarRequest)(nil),         // 9: user.UserAvatarRequest
+	(*UserAvatarResponse)(nil),        // 10: user.UserAvatarResponse
+	(*UserMediaRequest)(nil),          // 11: user.UserMediaRequest
+	(*UserMediaResponse)(nil),         // 12: user.UserMediaResponse
+	(*IdentifierRequest)(nil),         // 13: user.IdentifierRequest
+	(*IdentifierResponse)(nil),        // 14: user.IdentifierResponse
+	(*OAuthIdentity)(nil),             // 15: user.OAuthIdentity
+	(*OAuthRequest)(nil),              // 16: user.OAuthRequest
+	(*OAuthLoginResponse)(nil),        // 17: user.OAuthLoginResponse
+	(*OAuthBinding)(nil),              // 18: user.OAuthBinding
+	(*OAuthBindingsResponse)(nil),     // 19: user.OAuthBindingsResponse
+	(*UserSettings)(nil),              // 20: user.UserSettings
+	(*UserSettingsRequest)(nil),       // 21: user.UserSettingsRequest
+	(*UserSettingsResponse)(nil),      // 22: user.UserSettingsResponse
+	(*FollowRequest)(nil),             // 23: user.FollowRequest
+	(*FollowResponse)(nil),            // 24: user.FollowResponse
+	(*FollowListRequest)(nil),         // 25: user.FollowListRequest
+	(*FollowUser)(nil),                // 26: user.FollowUser
+	(*FollowListResponse)(nil),        // 27: user.FollowListResponse
+	(*PublicProfileRequest)(nil),      // 28: user.PublicProfileRequest
+	(*UserStats)(nil),                 // 29: user.UserStats
+	(*PublicProfileResponse)(nil),     // 30: user.PublicProfileResponse
+	(*BatchGetUserCardsRequest)(nil),  // 31: user.BatchGetUserCardsRequest
+	(*UserCard)(nil),                  // 32: user.UserCard
+	(*BatchGetUserCardsResponse)(nil), // 33: user.BatchGetUserCardsResponse
+	(*DeactivateAccountRequest)(nil),  // 34: user.DeactivateAccountRequest
+	(*DeactivateAccountResponse)(nil), // 35: user.DeactivateAccountResponse
+	(*ReactivateAccountRequest)(nil),  // 36: user.ReactivateAccountRequest
+	(*ReactivateAccountResponse)(nil), // 37: user.ReactivateAccountResponse
+	(*ChangePasswordRequest)(nil),     // 38: user.ChangePasswordRequest
+	(*PasswordResponse)(nil),          // 39: user.PasswordResponse
+	(*PasswordResetRequest)(nil),      // 40: user.PasswordResetRequest
+	(*PasswordResetResponse)(nil),     // 41: user.PasswordResetResponse
+	(*ResetPasswordRequest)(nil),      // 42: user.ResetPasswordRequest
+	(*AdminUser)(nil),                 // 43: user.AdminUser
+	(*AdminSearchUsersRequest)(nil),   // 44: user.AdminSearchUsersRequest
+	(*AdminSearchUsersResponse)(nil),  // 45: user.AdminSearchUsersResponse
+	(*AdminSetUserStatusRequest)(nil), // 46: user.AdminSetUserStatusRequest
+	(*AdminUserRequest)(nil),          // 47: user.AdminUserRequest
+	(*AdminUserBindingsResponse)(nil), // 48: user.AdminUserBindingsResponse
+	nil,                               // 49: user.UserAvatarResponse.FormFieldsEntry
+	nil,                               // 50: user.UserMediaResponse.FormFieldsEntry
 }
 var file_user_proto_depIdxs = []int32{
-	5, // 0: user.UserDataRequest.user_info:type_name -> user.UserInfo
-	0, // 1: user.UserService.VerifyPassword:input_type -> user.VerifyPasswordRequest
-	2, // 2: user.UserService.Register:input_type -> user.RegisterRequest
-	4, // 3: user.UserService.GetUserData:input_type -> user.UserDataRequest
-	4, // 4: user.UserService.SetUserData:input_type -> user.UserDataRequest
-	7, // 5: user.UserService.GetUserAvatar:input_type -> user.UserAvatarRequest
-	7, // 6: user.UserService.SetUserAvatar:input_type -> user.UserAvatarRequest
-	1, // 7: user.UserService.VerifyPassword:output_type -> user.VerifyPasswordResponse
-	3, // 8: user.UserService.Register:output_type -> user.RegisterResponse
-	6, // 9: user.UserService.GetUserData:output_type -> user.UserDataResponse
-	6, // 10: user.UserService.SetUserData:output_type -> user.UserDataResponse
-	8, // 11: user.UserService.GetUserAvatar:output_type -> user.UserAvatarResponse
-	8, // 12: user.UserService.SetUserAvatar:output_type -> user.UserAvatarResponse
-	7, // [7:13] is the sub-list for method output_type
-	1, // [1:7] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5,  // 0: user.UserDataRequest.user_info:type_name -> user.UserInfo
+	49, // 1: user.UserAvatarResponse.form_fields:type_name -> user.UserAvatarResponse.FormFieldsEntry
+	50, // 2: user.UserMediaResponse.form_fields:type_name -> user.UserMediaResponse.FormFieldsEntry
+	15, // 3: user.OAuthRequest.identity:type_name -> user.OAuthIdentity
+	18, // 4: user.OAuthBindingsResponse.bindings:type_name -> user.OAuthBinding
+	20, // 5: user.UserSettingsRequest.settings:type_name -> user.UserSettings
+	20, // 6: user.UserSettingsResponse.settings:type_name -> user.UserSettings
+	26, // 7: user.FollowListResponse.users:type_name -> user.FollowUser
+	29, // 8: user.PublicProfileResponse.stats:type_name -> user.UserStats
+	32, // 9: user.BatchGetUserCardsResponse.cards:type_name -> user.UserCard
+	43, // 10: user.AdminSearchUsersResponse.users:type_name -> user.AdminUser
+	14, // 11: user.AdminUserBindingsResponse.identifiers:type_name -> user.IdentifierResponse
+	18, // 12: user.AdminUserBindingsResponse.oauth_bindings:type_name -> user.OAuthBinding
+	0,  // 13: user.UserService.VerifyPassword:input_type -> user.VerifyPasswordRequest
+	2,  // 14: user.UserService.Register:input_type -> user.RegisterRequest
+	4,  // 15: user.UserService.GetUserData:input_type -> user.UserDataRequest
+	4,  // 16: user.UserService.SetUserData:input_type -> user.UserDataRequest
+	7,  // 17: user.UserService.GetUserRoles:input_type -> user.UserRolesRequest
+	9,  // 18: user.UserService.GetUserAvatar:input_type -> user.UserAvatarRequest
+	9,  // 19: user.UserService.SetUserAvatar:input_type -> user.UserAvatarRequest
+	9,  // 20: user.UserService.ConfirmUserAvatar:input_type -> user.UserAvatarRequest
+	11, // 21: user.UserService.SetUserMedia:input_type -> user.UserMediaRequest
+	11, // 22: user.UserService.ConfirmUserMedia:input_type -> user.UserMediaRequest
+	13, // 23: user.UserService.BindIdentifier:input_type -> user.IdentifierRequest
+	13, // 24: user.UserService.VerifyIdentifier:input_type -> user.IdentifierRequest
+	13, // 25: user.UserService.UnbindIdentifier:input_type -> user.IdentifierRequest
+	16, // 26: user.UserService.OAuthLogin:input_type -> user.OAuthRequest
+	16, // 27: user.UserService.BindOAuth:input_type -> user.OAuthRequest
+	16, // 28: user.UserService.UnbindOAuth:input_type -> user.OAuthRequest
+	16, // 29: user.UserService.ListOAuthBindings:input_type -> user.OAuthRequest
+	21, // 30: user.UserService.GetUserSettings:input_type -> user.UserSettingsRequest
+	21, // 31: user.UserService.UpdateUserSettings:input_type -> user.UserSettingsRequest
+	23, // 32: user.UserService.Follow:input_type -> user.FollowRequest
+	23, // 33: user.UserService.Unfollow:input_type -> user.FollowRequest
+	23, // 34: user.UserService.IsFollowing:input_type -> user.FollowRequest
+	25, // 35: user.UserService.ListFollowers:input_type -> user.FollowListRequest
+	25, // 36: user.UserService.ListFollowing:input_type -> user.FollowListRequest
+	28, // 37: user.UserService.GetPublicProfile:input_type -> user.PublicProfileRequest
+	31, // 38: user.UserService.BatchGetUserCards:input_type -> user.BatchGetUserCardsRequest
+	34, // 39: user.UserService.DeactivateAccount:input_type -> user.DeactivateAccountRequest
+	36, // 40: user.UserService.ReactivateAccount:input_type -> user.ReactivateAccountRequest
+	38, // 41: user.UserService.ChangePassword:input_type -> user.ChangePasswordRequest
+	40, // 42: user.UserService.RequestPasswordReset:input_type -> user.PasswordResetRequest
+	42, // 43: user.UserService.ResetPassword:input_type -> user.ResetPasswordRequest
+	44, // 44: user.UserService.AdminSearchUsers:input_type -> user.AdminSearchUsersRequest
+	46, // 45: user.UserService.AdminSetUserStatus:input_type -> user.AdminSetUserStatusRequest
+	47, // 46: user.UserService.AdminGetUserBindings:input_type -> user.AdminUserRequest
+	1,  // 47: user.UserService.VerifyPassword:output_type -> user.VerifyPasswordResponse
+	3,  // 48: user.UserService.Register:output_type -> user.RegisterResponse
+	6,  // 49: user.UserService.GetUserData:output_type -> user.UserDataResponse
+	6,  // 50: user.UserService.SetUserData:output_type -> user.UserDataResponse
+	8,  // 51: user.UserService.GetUserRoles:output_type -> user.UserRolesResponse
+	10, // 52: user.UserService.GetUserAvatar:output_type -> user.UserAvatarResponse
+	10, // 53: user.UserService.SetUserAvatar:output_type -> user.UserAvatarResponse
+	10, // 54: user.UserService.ConfirmUserAvatar:output_type -> user.UserAvatarResponse
+	12, // 55: user.UserService.SetUserMedia:output_type -> user.UserMediaResponse
+	12, // 56: user.UserService.ConfirmUserMedia:output_type -> user.UserMediaResponse
+	14, // 57: user.UserService.BindIdentifier:output_type -> user.IdentifierResponse
+	14, // 58: user.UserService.VerifyIdentifier:output_type -> user.IdentifierResponse
+	14, // 59: user.UserService.UnbindIdentifier:output_type -> user.IdentifierResponse
+	17, // 60: user.UserService.OAuthLogin:output_type -> user.OAuthLoginResponse
+	19, // 61: user.UserService.BindOAuth:output_type -> user.OAuthBindingsResponse
+	19, // 62: user.UserService.UnbindOAuth:output_type -> user.OAuthBindingsResponse
+	19, // 63: user.UserService.ListOAuthBindings:output_type -> user.OAuthBindingsResponse
+	22, // 64: user.UserService.GetUserSettings:output_type -> user.UserSettingsResponse
+	22, // 65: user.UserService.UpdateUserSettings:output_type -> user.UserSettingsResponse
+	24, // 66: user.UserService.Follow:output_type -> user.FollowResponse
+	24, // 67: user.UserService.Unfollow:output_type -> user.FollowResponse
+	24, // 68: user.UserService.IsFollowing:output_type -> user.FollowResponse
+	27, // 69: user.UserService.ListFollowers:output_type -> user.FollowListResponse
+	27, // 70: user.UserService.ListFollowing:output_type -> user.FollowListResponse
+	30, // 71: user.UserService.GetPublicProfile:output_type -> user.PublicProfileResponse
+	33, // 72: user.UserService.BatchGetUserCards:output_type -> user.BatchGetUserCardsResponse
+	35, // 73: user.UserService.DeactivateAccount:output_type -> user.DeactivateAccountResponse
+	37, // 74: user.UserService.ReactivateAccount:output_type -> user.ReactivateAccountResponse
+	39, // 75: user.UserService.ChangePassword:output_type -> user.PasswordResponse
+	41, // 76: user.UserService.RequestPasswordReset:output_type -> user.PasswordResetResponse
+	39, // 77: user.UserService.ResetPassword:output_type -> user.PasswordResponse
+	45, // 78: user.UserService.AdminSearchUsers:output_type -> user.AdminSearchUsersResponse
+	43, // 79: user.UserService.AdminSetUserStatus:output_type -> user.AdminUser
+	48, // 80: user.UserService.AdminGetUserBindings:output_type -> user.AdminUserBindingsResponse
+	47, // [47:81] is the sub-list for method output_type
+	13, // [13:47] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -793,13 +3825,14 @@ func file_user_proto_init() {
 	if File_user_proto != nil {
 		return
 	}
+	file_user_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_VerifyPassword_FullMethodName = "/user.UserService/VerifyPassword"
-	UserService_Register_FullMethodName       = "/user.UserService/Register"
-	UserService_GetUserData_FullMethodName    = "/user.UserService/GetUserData"
-	UserService_SetUserData_FullMethodName    = "/user.UserService/SetUserData"
-	UserService_GetUserAvatar_FullMethodName  = "/user.UserService/GetUserAvatar"
-	UserService_SetUserAvatar_FullMethodName  = "/user.UserService/SetUserAvatar"
+	UserService_VerifyPassword_FullMethodName       = "/user.UserService/VerifyPassword"
+	UserService_Register_FullMethodName             = "/user.UserService/Register"
+	UserService_GetUserData_FullMethodName          = "/user.UserService/GetUserData"
+	UserService_SetUserData_FullMethodName          = "/user.UserService/SetUserData"
+	UserService_GetUserRoles_FullMethodName         = "/user.UserService/GetUserRoles"
+	UserService_GetUserAvatar_FullMethodName        = "/user.UserService/GetUserAvatar"
+	UserService_SetUserAvatar_FullMethodName        = "/user.UserService/SetUserAvatar"
+	UserService_ConfirmUserAvatar_FullMethodName    = "/user.UserService/ConfirmUserAvatar"
+	UserService_SetUserMedia_FullMethodName         = "/user.UserService/SetUserMedia"
+	UserService_ConfirmUserMedia_FullMethodName     = "/user.UserService/ConfirmUserMedia"
+	UserService_BindIdentifier_FullMethodName       = "/user.UserService/BindIdentifier"
+	UserService_VerifyIdentifier_FullMethodName     = "/user.UserService/VerifyIdentifier"
+	UserService_UnbindIdentifier_FullMethodName     = "/user.UserService/UnbindIdentifier"
+	UserService_OAuthLogin_FullMethodName           = "/user.UserService/OAuthLogin"
+	UserService_BindOAuth_FullMethodName            = "/user.UserService/BindOAuth"
+	UserService_UnbindOAuth_FullMethodName          = "/user.UserService/UnbindOAuth"
+	UserService_ListOAuthBindings_FullMethodName    = "/user.UserService/ListOAuthBindings"
+	UserService_GetUserSettings_FullMethodName      = "/user.UserService/GetUserSettings"
+	UserService_UpdateUserSettings_FullMethodName   = "/user.UserService/UpdateUserSettings"
+	UserService_Follow_FullMethodName               = "/user.UserService/Follow"
+	UserService_Unfollow_FullMethodName             = "/user.UserService/Unfollow"
+	UserService_IsFollowing_FullMethodName          = "/user.UserService/IsFollowing"
+	UserService_ListFollowers_FullMethodName        = "/user.UserService/ListFollowers"
+	UserService_ListFollowing_FullMethodName        = "/user.UserService/ListFollowing"
+	UserService_GetPublicProfile_FullMethodName     = "/user.UserService/GetPublicProfile"
+	UserService_BatchGetUserCards_FullMethodName    = "/user.UserService/BatchGetUserCards"
+	UserService_DeactivateAccount_FullMethodName    = "/user.UserService/DeactivateAccount"
+	UserService_ReactivateAccount_FullMethodName    = "/user.UserService/ReactivateAccount"
+	UserService_ChangePassword_FullMethodName       = "/user.UserService/ChangePassword"
+	UserService_RequestPasswordReset_FullMethodName = "/user.UserService/RequestPasswordReset"
+	UserService_ResetPassword_FullMethodName        = "/user.UserService/ResetPassword"
+	UserService_AdminSearchUsers_FullMethodName     = "/user.UserService/AdminSearchUsers"
+	UserService_AdminSetUserStatus_FullMethodName   = "/user.UserService/AdminSetUserStatus"
+	UserService_AdminGetUserBindings_FullMethodName = "/user.UserService/AdminGetUserBindings"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -40,8 +68,72 @@ type UserServiceClient interface {
 	// GetUserData retrieves user profile data.
 	GetUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
 	SetUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
+	// GetUserRoles returns the current username and roles of an active account, so tokens are
+	// re-issued with what is stored now. NotFound for unknown or deactivated accounts,
+	// PermissionDenied for disabled ones.
+	GetUserRoles(ctx context.Context, in *UserRolesRequest, opts ...grpc.CallOption) (*UserRolesResponse, error)
 	GetUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error)
+	// SetUserAvatar is SetUserMedia for the avatar, or for the slot named in the request.
 	SetUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error)
+	// ConfirmUserAvatar is ConfirmUserMedia for the avatar, or for the slot named in the request.
+	ConfirmUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error)
+	// SetUserMedia starts an upload to a media slot and returns the presigned form.
+	SetUserMedia(ctx context.Context, in *UserMediaRequest, opts ...grpc.CallOption) (*UserMediaResponse, error)
+	// ConfirmUserMedia queues the object uploaded through SetUserMedia for processing; the processed
+	// image then replaces the user's image in that slot.
+	ConfirmUserMedia(ctx context.Context, in *UserMediaRequest, opts ...grpc.CallOption) (*UserMediaResponse, error)
+	// BindIdentifier attaches an unverified phone or email to a user and sends a verification code.
+	BindIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error)
+	// VerifyIdentifier confirms a bound phone or email with the code, enabling it for login.
+	VerifyIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error)
+	// UnbindIdentifier removes the user's phone or email of the given auth_type.
+	// FailedPrecondition (LAST_SIGN_IN_METHOD) when the account would be left without a way to sign in.
+	UnbindIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error)
+	// OAuthLogin finds the user bound to a third-party identity, creating one on first login.
+	OAuthLogin(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error)
+	// BindOAuth attaches a third-party identity to an existing user.
+	BindOAuth(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error)
+	// UnbindOAuth removes the user's binding for identity.platform.
+	// FailedPrecondition (LAST_SIGN_IN_METHOD) when the account would be left without a way to sign in.
+	UnbindOAuth(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error)
+	// ListOAuthBindings lists the third-party platforms bound to a user.
+	ListOAuthBindings(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error)
+	// GetUserSettings returns the user's settings, creating the row with defaults on first access.
+	GetUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error)
+	// UpdateUserSettings changes only the fields present in the request.
+	UpdateUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error)
+	// Follow makes user_id follow target_user_id. Following twice is a no-op.
+	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+	// Unfollow removes the relationship. Unfollowing a user not followed is a no-op.
+	Unfollow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+	// IsFollowing reports the relationship between user_id and target_user_id in both directions.
+	IsFollowing(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+	// ListFollowers lists who follows user_id, newest first.
+	// PermissionDenied (LIST_HIDDEN) when user_id's privacy_level or show_followers hides it from viewer_id.
+	ListFollowers(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error)
+	// ListFollowing lists who user_id follows, newest first.
+	// PermissionDenied (LIST_HIDDEN) when user_id's privacy_level or show_following hides it from viewer_id.
+	ListFollowing(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error)
+	// GetPublicProfile returns what viewer_id may see of user_id's profile under their privacy_level.
+	GetPublicProfile(ctx context.Context, in *PublicProfileRequest, opts ...grpc.CallOption) (*PublicProfileResponse, error)
+	// BatchGetUserCards hydrates up to 100 user ids into nickname and avatar cards in one call.
+	BatchGetUserCards(ctx context.Context, in *BatchGetUserCardsRequest, opts ...grpc.CallOption) (*BatchGetUserCardsResponse, error)
+	// DeactivateAccount soft-deletes user_id. The account is purged once the grace period ends.
+	DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error)
+	// ReactivateAccount restores a deactivated account by password while the grace period lasts.
+	ReactivateAccount(ctx context.Context, in *ReactivateAccountRequest, opts ...grpc.CallOption) (*ReactivateAccountResponse, error)
+	// ChangePassword replaces user_id's password after checking the old one.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error)
+	// RequestPasswordReset sends a reset token to a verified email or phone without revealing whether the account exists.
+	RequestPasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*PasswordResetResponse, error)
+	// ResetPassword sets a new password with a token from RequestPasswordReset. Tokens are single use.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error)
+	// AdminSearchUsers pages through accounts matching the filters, newest first. Admin only.
+	AdminSearchUsers(ctx context.Context, in *AdminSearchUsersRequest, opts ...grpc.CallOption) (*AdminSearchUsersResponse, error)
+	// AdminSetUserStatus disables or re-enables an account. Admin only.
+	AdminSetUserStatus(ctx context.Context, in *AdminSetUserStatusRequest, opts ...grpc.CallOption) (*AdminUser, error)
+	// AdminGetUserBindings lists every login method of an account, unverified ones included. Admin only.
+	AdminGetUserBindings(ctx context.Context, in *AdminUserRequest, opts ...grpc.CallOption) (*AdminUserBindingsResponse, error)
 }
 
 type userServiceClient struct {
@@ -92,6 +184,16 @@ func (c *userServiceClient) SetUserData(ctx context.Context, in *UserDataRequest
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserRoles(ctx context.Context, in *UserRolesRequest, opts ...grpc.CallOption) (*UserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserRolesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserAvatarResponse)
@@ -112,6 +214,276 @@ func (c *userServiceClient) SetUserAvatar(ctx context.Context, in *UserAvatarReq
 	return out, nil
 }
 
+func (c *userServiceClient) ConfirmUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserAvatarResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmUserAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetUserMedia(ctx context.Context, in *UserMediaRequest, opts ...grpc.CallOption) (*UserMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserMediaResponse)
+	err := c.cc.Invoke(ctx, UserService_SetUserMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmUserMedia(ctx context.Context, in *UserMediaRequest, opts ...grpc.CallOption) (*UserMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserMediaResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmUserMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BindIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IdentifierResponse)
+	err := c.cc.Invoke(ctx, UserService_BindIdentifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IdentifierResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyIdentifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnbindIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IdentifierResponse)
+	err := c.cc.Invoke(ctx, UserService_UnbindIdentifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) OAuthLogin(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthLoginResponse)
+	err := c.cc.Invoke(ctx, UserService_OAuthLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BindOAuth(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthBindingsResponse)
+	err := c.cc.Invoke(ctx, UserService_BindOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnbindOAuth(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthBindingsResponse)
+	err := c.cc.Invoke(ctx, UserService_UnbindOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListOAuthBindings(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuthBindingsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListOAuthBindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, UserService_Follow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Unfollow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, UserService_Unfollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) IsFollowing(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, UserService_IsFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListFollowers(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowListResponse)
+	err := c.cc.Invoke(ctx, UserService_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListFollowing(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowListResponse)
+	err := c.cc.Invoke(ctx, UserService_ListFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPublicProfile(ctx context.Context, in *PublicProfileRequest, opts ...grpc.CallOption) (*PublicProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublicProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPublicProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchGetUserCards(ctx context.Context, in *BatchGetUserCardsRequest, opts ...grpc.CallOption) (*BatchGetUserCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetUserCardsResponse)
+	err := c.cc.Invoke(ctx, UserService_BatchGetUserCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_DeactivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReactivateAccount(ctx context.Context, in *ReactivateAccountRequest, opts ...grpc.CallOption) (*ReactivateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_ReactivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestPasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*PasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordResetResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordResponse)
+	err := c.cc.Invoke(ctx, UserService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AdminSearchUsers(ctx context.Context, in *AdminSearchUsersRequest, opts ...grpc.CallOption) (*AdminSearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminSearchUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_AdminSearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AdminSetUserStatus(ctx context.Context, in *AdminSetUserStatusRequest, opts ...grpc.CallOption) (*AdminUser, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUser)
+	err := c.cc.Invoke(ctx, UserService_AdminSetUserStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AdminGetUserBindings(ctx context.Context, in *AdminUserRequest, opts ...grpc.CallOption) (*AdminUserBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUserBindingsResponse)
+	err := c.cc.Invoke(ctx, UserService_AdminGetUserBindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -125,8 +497,72 @@ type UserServiceServer interface {
 	// GetUserData retrieves user profile data.
 	GetUserData(context.Context, *UserDataRequest) (*UserDataResponse, error)
 	SetUserData(context.Context, *UserDataRequest) (*UserDataResponse, error)
+	// GetUserRoles returns the current username and roles of an active account, so tokens are
+	// re-issued with what is stored now. NotFound for unknown or deactivated accounts,
+	// PermissionDenied for disabled ones.
+	GetUserRoles(context.Context, *UserRolesRequest) (*UserRolesResponse, error)
 	GetUserAvatar(context.Context, *UserAvatarRequest) (*UserAvatarResponse, error)
+	// SetUserAvatar is SetUserMedia for the avatar, or for the slot named in the request.
 	SetUserAvatar(context.Context, *UserAvatarRequest) (*UserAvatarResponse, error)
+	// ConfirmUserAvatar is ConfirmUserMedia for the avatar, or for the slot named in the request.
+	ConfirmUserAvatar(context.Context, *UserAvatarRequest) (*UserAvatarResponse, error)
+	// SetUserMedia starts an upload to a media slot and returns the presigned form.
+	SetUserMedia(context.Context, *UserMediaRequest) (*UserMediaResponse, error)
+	// ConfirmUserMedia queues the object uploaded through SetUserMedia for processing; the processed
+	// image then replaces the user's image in that slot.
+	ConfirmUserMedia(context.Context, *UserMediaRequest) (*UserMediaResponse, error)
+	// BindIdentifier attaches an unverified phone or email to a user and sends a verification code.
+	BindIdentifier(context.Context, *IdentifierRequest) (*IdentifierResponse, error)
+	// VerifyIdentifier confirms a bound phone or email with the code, enabling it for login.
+	VerifyIdentifier(context.Context, *IdentifierRequest) (*IdentifierResponse, error)
+	// UnbindIdentifier removes the user's phone or email of the given auth_type.
+	// FailedPrecondition (LAST_SIGN_IN_METHOD) when the account would be left without a way to sign in.
+	UnbindIdentifier(context.Context, *IdentifierRequest) (*IdentifierResponse, error)
+	// OAuthLogin finds the user bound to a third-party identity, creating one on first login.
+	OAuthLogin(context.Context, *OAuthRequest) (*OAuthLoginResponse, error)
+	// BindOAuth attaches a third-party identity to an existing user.
+	BindOAuth(context.Context, *OAuthRequest) (*OAuthBindingsResponse, error)
+	// UnbindOAuth removes the user's binding for identity.platform.
+	// FailedPrecondition (LAST_SIGN_IN_METHOD) when the account would be left without a way to sign in.
+	UnbindOAuth(context.Context, *OAuthRequest) (*OAuthBindingsResponse, error)
+	// ListOAuthBindings lists the third-party platforms bound to a user.
+	ListOAuthBindings(context.Context, *OAuthRequest) (*OAuthBindingsResponse, error)
+	// GetUserSettings returns the user's settings, creating the row with defaults on first access.
+	GetUserSettings(context.Context, *UserSettingsRequest) (*UserSettingsResponse, error)
+	// UpdateUserSettings changes only the fields present in the request.
+	UpdateUserSettings(context.Context, *UserSettingsRequest) (*UserSettingsResponse, error)
+	// Follow makes user_id follow target_user_id. Following twice is a no-op.
+	Follow(context.Context, *FollowRequest) (*FollowResponse, error)
+	// Unfollow removes the relationship. Unfollowing a user not followed is a no-op.
+	Unfollow(context.Context, *FollowRequest) (*FollowResponse, error)
+	// IsFollowing reports the relationship between user_id and target_user_id in both directions.
+	IsFollowing(context.Context, *FollowRequest) (*FollowResponse, error)
+	// ListFollowers lists who follows user_id, newest first.
+	// PermissionDenied (LIST_HIDDEN) when user_id's privacy_level or show_followers hides it from viewer_id.
+	ListFollowers(context.Context, *FollowListRequest) (*FollowListResponse, error)
+	// ListFollowing lists who user_id follows, newest first.
+	// PermissionDenied (LIST_HIDDEN) when user_id's privacy_level or show_following hides it from viewer_id.
+	ListFollowing(context.Context, *FollowListRequest) (*FollowListResponse, error)
+	// GetPublicProfile returns what viewer_id may see of user_id's profile under their privacy_level.
+	GetPublicProfile(context.Context, *PublicProfileRequest) (*PublicProfileResponse, error)
+	// BatchGetUserCards hydrates up to 100 user ids into nickname and avatar cards in one call.
+	BatchGetUserCards(context.Context, *BatchGetUserCardsRequest) (*BatchGetUserCardsResponse, error)
+	// DeactivateAccount soft-deletes user_id. The account is purged once the grace period ends.
+	DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error)
+	// ReactivateAccount restores a deactivated account by password while the grace period lasts.
+	ReactivateAccount(context.Context, *ReactivateAccountRequest) (*ReactivateAccountResponse, error)
+	// ChangePassword replaces user_id's password after checking the old one.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*PasswordResponse, error)
+	// RequestPasswordReset sends a reset token to a verified email or phone without revealing whether the account exists.
+	RequestPasswordReset(context.Context, *PasswordResetRequest) (*PasswordResetResponse, error)
+	// ResetPassword sets a new password with a token from RequestPasswordReset. Tokens are single use.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*PasswordResponse, error)
+	// AdminSearchUsers pages through accounts matching the filters, newest first. Admin only.
+	AdminSearchUsers(context.Context, *AdminSearchUsersRequest) (*AdminSearchUsersResponse, error)
+	// AdminSetUserStatus disables or re-enables an account. Admin only.
+	AdminSetUserStatus(context.Context, *AdminSetUserStatusRequest) (*AdminUser, error)
+	// AdminGetUserBindings lists every login method of an account, unverified ones included. Admin only.
+	AdminGetUserBindings(context.Context, *AdminUserRequest) (*AdminUserBindingsResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -149,12 +585,96 @@ func (UnimplementedUserServiceServer) GetUserData(context.Context, *UserDataRequ
 func (UnimplementedUserServiceServer) SetUserData(context.Context, *UserDataRequest) (*UserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserData not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserRoles(context.Context, *UserRolesRequest) (*UserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRoles not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserAvatar(context.Context, *UserAvatarRequest) (*UserAvatarResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatar not implemented")
 }
 func (UnimplementedUserServiceServer) SetUserAvatar(context.Context, *UserAvatarRequest) (*UserAvatarResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserAvatar not implemented")
 }
+func (UnimplementedUserServiceServer) ConfirmUserAvatar(context.Context, *UserAvatarRequest) (*UserAvatarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmUserAvatar not implemented")
+}
+func (UnimplementedUserServiceServer) SetUserMedia(context.Context, *UserMediaRequest) (*UserMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserMedia not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmUserMedia(context.Context, *UserMediaRequest) (*UserMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmUserMedia not implemented")
+}
+func (UnimplementedUserServiceServer) BindIdentifier(context.Context, *IdentifierRequest) (*IdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindIdentifier not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyIdentifier(context.Context, *IdentifierRequest) (*IdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIdentifier not implemented")
+}
+func (UnimplementedUserServiceServer) UnbindIdentifier(context.Context, *IdentifierRequest) (*IdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindIdentifier not implemented")
+}
+func (UnimplementedUserServiceServer) OAuthLogin(context.Context, *OAuthRequest) (*OAuthLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OAuthLogin not implemented")
+}
+func (UnimplementedUserServiceServer) BindOAuth(context.Context, *OAuthRequest) (*OAuthBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindOAuth not implemented")
+}
+func (UnimplementedUserServiceServer) UnbindOAuth(context.Context, *OAuthRequest) (*OAuthBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindOAuth not implemented")
+}
+func (UnimplementedUserServiceServer) ListOAuthBindings(context.Context, *OAuthRequest) (*OAuthBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOAuthBindings not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserSettings(context.Context, *UserSettingsRequest) (*UserSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSettings not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserSettings(context.Context, *UserSettingsRequest) (*UserSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserSettings not implemented")
+}
+func (UnimplementedUserServiceServer) Follow(context.Context, *FollowRequest) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedUserServiceServer) Unfollow(context.Context, *FollowRequest) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedUserServiceServer) IsFollowing(context.Context, *FollowRequest) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFollowing not implemented")
+}
+func (UnimplementedUserServiceServer) ListFollowers(context.Context, *FollowListRequest) (*FollowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedUserServiceServer) ListFollowing(context.Context, *FollowListRequest) (*FollowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedUserServiceServer) GetPublicProfile(context.Context, *PublicProfileRequest) (*PublicProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfile not implemented")
+}
+func (UnimplementedUserServiceServer) BatchGetUserCards(context.Context, *BatchGetUserCardsRequest) (*BatchGetUserCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUserCards not implemented")
+}
+func (UnimplementedUserServiceServer) DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAccount not implemented")
+}
+func (UnimplementedUserServiceServer) ReactivateAccount(context.Context, *ReactivateAccountRequest) (*ReactivateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateAccount not implemented")
+}
+func (UnimplementedUserServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*PasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserServiceServer) RequestPasswordReset(context.Context, *PasswordResetRequest) (*PasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedUserServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*PasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserServiceServer) AdminSearchUsers(context.Context, *AdminSearchUsersRequest) (*AdminSearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSearchUsers not implemented")
+}
+func (UnimplementedUserServiceServer) AdminSetUserStatus(context.Context, *AdminSetUserStatusRequest) (*AdminUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSetUserStatus not implemented")
+}
+func (UnimplementedUserServiceServer) AdminGetUserBindings(context.Context, *AdminUserRequest) (*AdminUserBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetUserBindings not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -248,6 +768,24 @@ func _UserService_SetUserData_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserRoles(ctx, req.(*UserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUserAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserAvatarRequest)
 	if err := dec(in); err != nil {
@@ -284,29 +822,519 @@ func _UserService_SetUserAvatar_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "user.UserService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "VerifyPassword",
-			Handler:    _UserService_VerifyPassword_Handler,
-		},
-		{
-			MethodName: "Register",
-			Handler:    _UserService_Register_Handler,
-		},
-		{
-			MethodName: "GetUserData",
-			Handler:    _UserService_GetUserData_Handler,
+func _UserService_ConfirmUserAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmUserAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmUserAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmUserAvatar(ctx, req.(*UserAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetUserMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetUserMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetUserMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetUserMedia(ctx, req.(*UserMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmUserMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmUserMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmUserMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmUserMedia(ctx, req.(*UserMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BindIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BindIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BindIdentifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BindIdentifier(ctx, req.(*IdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyIdentifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyIdentifier(ctx, req.(*IdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnbindIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnbindIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnbindIdentifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnbindIdentifier(ctx, req.(*IdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_OAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).OAuthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_OAuthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).OAuthLogin(ctx, req.(*OAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BindOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BindOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BindOAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BindOAuth(ctx, req.(*OAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnbindOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnbindOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnbindOAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnbindOAuth(ctx, req.(*OAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListOAuthBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListOAuthBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListOAuthBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListOAuthBindings(ctx, req.(*OAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserSettings(ctx, req.(*UserSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserSettings(ctx, req.(*UserSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Follow(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Unfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Unfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Unfollow(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IsFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IsFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_IsFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IsFollowing(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListFollowers(ctx, req.(*FollowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListFollowing(ctx, req.(*FollowListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPublicProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPublicProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPublicProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPublicProfile(ctx, req.(*PublicProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BatchGetUserCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUserCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BatchGetUserCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BatchGetUserCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BatchGetUserCards(ctx, req.(*BatchGetUserCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeactivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeactivateAccount(ctx, req.(*DeactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReactivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReactivateAccount(ctx, req.(*ReactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestPasswordReset(ctx, req.(*PasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AdminSearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AdminSearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AdminSearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AdminSearchUsers(ctx, req.(*AdminSearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AdminSetUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AdminSetUserStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AdminSetUserStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AdminSetUserStatus(ctx, req.(*AdminSetUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AdminGetUserBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AdminGetUserBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AdminGetUserBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AdminGetUserBindings(ctx, req.(*AdminUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyPassword",
+			Handler:    _UserService_VerifyPassword_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _UserService_Register_Handler,
+		},
+		{
+			MethodName: "GetUserData",
+			Handler:    _UserService_GetUserData_Handler,
 		},
 		{
 			MethodName: "SetUserData",
 			Handler:    _UserService_SetUserData_Handler,
 		},
+		{
+			MethodName: "GetUserRoles",
+			Handler:    _UserService_GetUserRoles_Handler,
+		},
 		{
 			MethodName: "GetUserAvatar",
 			Handler:    _UserService_GetUserAvatar_Handler,
@@ -315,6 +1343,114 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetUserAvatar",
 			Handler:    _UserService_SetUserAvatar_Handler,
 		},
+		{
+			MethodName: "ConfirmUserAvatar",
+			Handler:    _UserService_ConfirmUserAvatar_Handler,
+		},
+		{
+			MethodName: "SetUserMedia",
+			Handler:    _UserService_SetUserMedia_Handler,
+		},
+		{
+			MethodName: "ConfirmUserMedia",
+			Handler:    _UserService_ConfirmUserMedia_Handler,
+		},
+		{
+			MethodName: "BindIdentifier",
+			Handler:    _UserService_BindIdentifier_Handler,
+		},
+		{
+			MethodName: "VerifyIdentifier",
+			Handler:    _UserService_VerifyIdentifier_Handler,
+		},
+		{
+			MethodName: "UnbindIdentifier",
+			Handler:    _UserService_UnbindIdentifier_Handler,
+		},
+		{
+			MethodName: "OAuthLogin",
+			Handler:    _UserService_OAuthLogin_Handler,
+		},
+		{
+			MethodName: "BindOAuth",
+			Handler:    _UserService_BindOAuth_Handler,
+		},
+		{
+			MethodName: "UnbindOAuth",
+			Handler:    _UserService_UnbindOAuth_Handler,
+		},
+		{
+			MethodName: "ListOAuthBindings",
+			Handler:    _UserService_ListOAuthBindings_Handler,
+		},
+		{
+			MethodName: "GetUserSettings",
+			Handler:    _UserService_GetUserSettings_Handler,
+		},
+		{
+			MethodName: "UpdateUserSettings",
+			Handler:    _UserService_UpdateUserSettings_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _UserService_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _UserService_Unfollow_Handler,
+		},
+		{
+			MethodName: "IsFollowing",
+			Handler:    _UserService_IsFollowing_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _UserService_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _UserService_ListFollowing_Handler,
+		},
+		{
+			MethodName: "GetPublicProfile",
+			Handler:    _UserService_GetPublicProfile_Handler,
+		},
+		{
+			MethodName: "BatchGetUserCards",
+			Handler:    _UserService_BatchGetUserCards_Handler,
+		},
+		{
+			MethodName: "DeactivateAccount",
+			Handler:    _UserService_DeactivateAccount_Handler,
+		},
+		{
+			MethodName: "ReactivateAccount",
+			Handler:    _UserService_ReactivateAccount_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _UserService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _UserService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "AdminSearchUsers",
+			Handler:    _UserService_AdminSearchUsers_Handler,
+		},
+		{
+			MethodName: "AdminSetUserStatus",
+			Handler:    _UserService_AdminSetUserStatus_Handler,
+		},
+		{
+			MethodName: "AdminGetUserBindings",
+			Handler:    _UserService_AdminGetUserBindings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
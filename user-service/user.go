@@ -6,8 +6,10 @@ import (
 
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/conf"
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/config"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/job"
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/server"
 	"github.com/GUET-BAT/Astraios-S/user-service/internal/svc"
+	"github.com/GUET-BAT/Astraios-S/user-service/internal/util"
 	"github.com/GUET-BAT/Astraios-S/user-service/pb/userpb"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -36,8 +38,21 @@ func main() {
 			reflection.Register(grpcServer)
 		}
 	})
-	defer s.Stop()
+
+	group := service.NewServiceGroup()
+	defer group.Stop()
+	group.Add(s)
+	if c.Account.PurgeIntervalSeconds > 0 {
+		group.Add(job.NewAccountPurger(ctx))
+	}
+	if c.Avatar.ProcessIntervalSeconds > 0 {
+		group.Add(job.NewMediaProcessor(ctx))
+	}
+	// The local storage driver serves its presigned URLs itself.
+	if store, ok := ctx.ObjectStore.(*util.LocalStore); ok {
+		group.Add(store)
+	}
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
-	s.Start()
+	group.Start()
 }
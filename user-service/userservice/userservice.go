@@ -14,15 +14,55 @@ import (
 )
 
 type (
-	RegisterRequest        = userpb.RegisterRequest
-	RegisterResponse       = userpb.RegisterResponse
-	UserAvatarRequest      = userpb.UserAvatarRequest
-	UserAvatarResponse     = userpb.UserAvatarResponse
-	UserDataRequest        = userpb.UserDataRequest
-	UserDataResponse       = userpb.UserDataResponse
-	UserInfo               = userpb.UserInfo
-	VerifyPasswordRequest  = userpb.VerifyPasswordRequest
-	VerifyPasswordResponse = userpb.VerifyPasswordResponse
+	AdminSearchUsersRequest   = userpb.AdminSearchUsersRequest
+	AdminSearchUsersResponse  = userpb.AdminSearchUsersResponse
+	AdminSetUserStatusRequest = userpb.AdminSetUserStatusRequest
+	AdminUser                 = userpb.AdminUser
+	AdminUserBindingsResponse = userpb.AdminUserBindingsResponse
+	AdminUserRequest          = userpb.AdminUserRequest
+	BatchGetUserCardsRequest  = userpb.BatchGetUserCardsRequest
+	BatchGetUserCardsResponse = userpb.BatchGetUserCardsResponse
+	ChangePasswordRequest     = userpb.ChangePasswordRequest
+	DeactivateAccountRequest  = userpb.DeactivateAccountRequest
+	DeactivateAccountResponse = userpb.DeactivateAccountResponse
+	FollowListRequest         = userpb.FollowListRequest
+	FollowListResponse        = userpb.FollowListResponse
+	FollowRequest             = userpb.FollowRequest
+	FollowResponse            = userpb.FollowResponse
+	FollowUser                = userpb.FollowUser
+	IdentifierRequest         = userpb.IdentifierRequest
+	IdentifierResponse        = userpb.IdentifierResponse
+	OAuthBinding              = userpb.OAuthBinding
+	OAuthBindingsResponse     = userpb.OAuthBindingsResponse
+	OAuthIdentity             = userpb.OAuthIdentity
+	OAuthLoginResponse        = userpb.OAuthLoginResponse
+	OAuthRequest              = userpb.OAuthRequest
+	PasswordResetRequest      = userpb.PasswordResetRequest
+	PasswordResetResponse     = userpb.PasswordResetResponse
+	PasswordResponse          = userpb.PasswordResponse
+	PublicProfileRequest      = userpb.PublicProfileRequest
+	PublicProfileResponse     = userpb.PublicProfileResponse
+	ReactivateAccountRequest  = userpb.ReactivateAccountRequest
+	ReactivateAccountResponse = userpb.ReactivateAccountResponse
+	RegisterRequest           = userpb.RegisterRequest
+	RegisterResponse          = userpb.RegisterResponse
+	ResetPasswordRequest      = userpb.ResetPasswordRequest
+	UserAvatarRequest         = userpb.UserAvatarRequest
+	UserAvatarResponse        = userpb.UserAvatarResponse
+	UserCard                  = userpb.UserCard
+	UserDataRequest           = userpb.UserDataRequest
+	UserDataResponse          = userpb.UserDataResponse
+	UserInfo                  = userpb.UserInfo
+	UserMediaRequest          = userpb.UserMediaRequest
+	UserMediaResponse         = userpb.UserMediaResponse
+	UserRolesRequest          = userpb.UserRolesRequest
+	UserRolesResponse         = userpb.UserRolesResponse
+	UserSettings              = userpb.UserSettings
+	UserSettingsRequest       = userpb.UserSettingsRequest
+	UserSettingsResponse      = userpb.UserSettingsResponse
+	UserStats                 = userpb.UserStats
+	VerifyPasswordRequest     = userpb.VerifyPasswordRequest
+	VerifyPasswordResponse    = userpb.VerifyPasswordResponse
 
 	UserService interface {
 		// VerifyPassword validates user credentials.
@@ -32,8 +72,65 @@ type (
 		// GetUserData retrieves user profile data.
 		GetUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
 		SetUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
+		// GetUserRoles returns the current username and roles of an active account, so tokens are
+		GetUserRoles(ctx context.Context, in *UserRolesRequest, opts ...grpc.CallOption) (*UserRolesResponse, error)
 		GetUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error)
+		// SetUserAvatar is SetUserMedia for the avatar, or for the slot named in the request.
 		SetUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error)
+		// ConfirmUserAvatar is ConfirmUserMedia for the avatar, or for the slot named in the request.
+		ConfirmUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error)
+		// SetUserMedia starts an upload to a media slot and returns the presigned form.
+		SetUserMedia(ctx context.Context, in *UserMediaRequest, opts ...grpc.CallOption) (*UserMediaResponse, error)
+		// ConfirmUserMedia queues the object uploaded through SetUserMedia for processing; the processed
+		ConfirmUserMedia(ctx context.Context, in *UserMediaRequest, opts ...grpc.CallOption) (*UserMediaResponse, error)
+		// BindIdentifier attaches an unverified phone or email to a user and sends a verification code.
+		BindIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error)
+		// VerifyIdentifier confirms a bound phone or email with the code, enabling it for login.
+		VerifyIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error)
+		// UnbindIdentifier removes the user's phone or email of the given auth_type.
+		UnbindIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error)
+		// OAuthLogin finds the user bound to a third-party identity, creating one on first login.
+		OAuthLogin(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error)
+		// BindOAuth attaches a third-party identity to an existing user.
+		BindOAuth(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error)
+		// UnbindOAuth removes the user's binding for identity.platform.
+		UnbindOAuth(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error)
+		// ListOAuthBindings lists the third-party platforms bound to a user.
+		ListOAuthBindings(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error)
+		// GetUserSettings returns the user's settings, creating the row with defaults on first access.
+		GetUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error)
+		// UpdateUserSettings changes only the fields present in the request.
+		UpdateUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error)
+		// Follow makes user_id follow target_user_id. Following twice is a no-op.
+		Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+		// Unfollow removes the relationship. Unfollowing a user not followed is a no-op.
+		Unfollow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+		// IsFollowing reports the relationship between user_id and target_user_id in both directions.
+		IsFollowing(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+		// ListFollowers lists who follows user_id, newest first.
+		ListFollowers(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error)
+		// ListFollowing lists who user_id follows, newest first.
+		ListFollowing(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error)
+		// GetPublicProfile returns what viewer_id may see of user_id's profile under their privacy_level.
+		GetPublicProfile(ctx context.Context, in *PublicProfileRequest, opts ...grpc.CallOption) (*PublicProfileResponse, error)
+		// BatchGetUserCards hydrates up to 100 user ids into nickname and avatar cards in one call.
+		BatchGetUserCards(ctx context.Context, in *BatchGetUserCardsRequest, opts ...grpc.CallOption) (*BatchGetUserCardsResponse, error)
+		// DeactivateAccount soft-deletes user_id. The account is purged once the grace period ends.
+		DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error)
+		// ReactivateAccount restores a deactivated account by password while the grace period lasts.
+		ReactivateAccount(ctx context.Context, in *ReactivateAccountRequest, opts ...grpc.CallOption) (*ReactivateAccountResponse, error)
+		// ChangePassword replaces user_id's password after checking the old one.
+		ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error)
+		// RequestPasswordReset sends a reset token to a verified email or phone without revealing whether the account exists.
+		RequestPasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*PasswordResetResponse, error)
+		// ResetPassword sets a new password with a token from RequestPasswordReset. Tokens are single use.
+		ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error)
+		// AdminSearchUsers pages through accounts matching the filters, newest first. Admin only.
+		AdminSearchUsers(ctx context.Context, in *AdminSearchUsersRequest, opts ...grpc.CallOption) (*AdminSearchUsersResponse, error)
+		// AdminSetUserStatus disables or re-enables an account. Admin only.
+		AdminSetUserStatus(ctx context.Context, in *AdminSetUserStatusRequest, opts ...grpc.CallOption) (*AdminUser, error)
+		// AdminGetUserBindings lists every login method of an account, unverified ones included. Admin only.
+		AdminGetUserBindings(ctx context.Context, in *AdminUserRequest, opts ...grpc.CallOption) (*AdminUserBindingsResponse, error)
 	}
 
 	defaultUserService struct {
@@ -70,12 +167,181 @@ func (m *defaultUserService) SetUserData(ctx context.Context, in *UserDataReques
 	return client.SetUserData(ctx, in, opts...)
 }
 
+// GetUserRoles returns the current username and roles of an active account, so tokens are
+func (m *defaultUserService) GetUserRoles(ctx context.Context, in *UserRolesRequest, opts ...grpc.CallOption) (*UserRolesResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.GetUserRoles(ctx, in, opts...)
+}
+
 func (m *defaultUserService) GetUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error) {
 	client := userpb.NewUserServiceClient(m.cli.Conn())
 	return client.GetUserAvatar(ctx, in, opts...)
 }
 
+// SetUserAvatar is SetUserMedia for the avatar, or for the slot named in the request.
 func (m *defaultUserService) SetUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error) {
 	client := userpb.NewUserServiceClient(m.cli.Conn())
 	return client.SetUserAvatar(ctx, in, opts...)
 }
+
+// ConfirmUserAvatar is ConfirmUserMedia for the avatar, or for the slot named in the request.
+func (m *defaultUserService) ConfirmUserAvatar(ctx context.Context, in *UserAvatarRequest, opts ...grpc.CallOption) (*UserAvatarResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.ConfirmUserAvatar(ctx, in, opts...)
+}
+
+// SetUserMedia starts an upload to a media slot and returns the presigned form.
+func (m *defaultUserService) SetUserMedia(ctx context.Context, in *UserMediaRequest, opts ...grpc.CallOption) (*UserMediaResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.SetUserMedia(ctx, in, opts...)
+}
+
+// ConfirmUserMedia queues the object uploaded through SetUserMedia for processing; the processed
+func (m *defaultUserService) ConfirmUserMedia(ctx context.Context, in *UserMediaRequest, opts ...grpc.CallOption) (*UserMediaResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.ConfirmUserMedia(ctx, in, opts...)
+}
+
+// BindIdentifier attaches an unverified phone or email to a user and sends a verification code.
+func (m *defaultUserService) BindIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.BindIdentifier(ctx, in, opts...)
+}
+
+// VerifyIdentifier confirms a bound phone or email with the code, enabling it for login.
+func (m *defaultUserService) VerifyIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.VerifyIdentifier(ctx, in, opts...)
+}
+
+// UnbindIdentifier removes the user's phone or email of the given auth_type.
+func (m *defaultUserService) UnbindIdentifier(ctx context.Context, in *IdentifierRequest, opts ...grpc.CallOption) (*IdentifierResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.UnbindIdentifier(ctx, in, opts...)
+}
+
+// OAuthLogin finds the user bound to a third-party identity, creating one on first login.
+func (m *defaultUserService) OAuthLogin(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthLoginResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.OAuthLogin(ctx, in, opts...)
+}
+
+// BindOAuth attaches a third-party identity to an existing user.
+func (m *defaultUserService) BindOAuth(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.BindOAuth(ctx, in, opts...)
+}
+
+// UnbindOAuth removes the user's binding for identity.platform.
+func (m *defaultUserService) UnbindOAuth(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.UnbindOAuth(ctx, in, opts...)
+}
+
+// ListOAuthBindings lists the third-party platforms bound to a user.
+func (m *defaultUserService) ListOAuthBindings(ctx context.Context, in *OAuthRequest, opts ...grpc.CallOption) (*OAuthBindingsResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.ListOAuthBindings(ctx, in, opts...)
+}
+
+// GetUserSettings returns the user's settings, creating the row with defaults on first access.
+func (m *defaultUserService) GetUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.GetUserSettings(ctx, in, opts...)
+}
+
+// UpdateUserSettings changes only the fields present in the request.
+func (m *defaultUserService) UpdateUserSettings(ctx context.Context, in *UserSettingsRequest, opts ...grpc.CallOption) (*UserSettingsResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.UpdateUserSettings(ctx, in, opts...)
+}
+
+// Follow makes user_id follow target_user_id. Following twice is a no-op.
+func (m *defaultUserService) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.Follow(ctx, in, opts...)
+}
+
+// Unfollow removes the relationship. Unfollowing a user not followed is a no-op.
+func (m *defaultUserService) Unfollow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.Unfollow(ctx, in, opts...)
+}
+
+// IsFollowing reports the relationship between user_id and target_user_id in both directions.
+func (m *defaultUserService) IsFollowing(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.IsFollowing(ctx, in, opts...)
+}
+
+// ListFollowers lists who follows user_id, newest first.
+func (m *defaultUserService) ListFollowers(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.ListFollowers(ctx, in, opts...)
+}
+
+// ListFollowing lists who user_id follows, newest first.
+func (m *defaultUserService) ListFollowing(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.ListFollowing(ctx, in, opts...)
+}
+
+// GetPublicProfile returns what viewer_id may see of user_id's profile under their privacy_level.
+func (m *defaultUserService) GetPublicProfile(ctx context.Context, in *PublicProfileRequest, opts ...grpc.CallOption) (*PublicProfileResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.GetPublicProfile(ctx, in, opts...)
+}
+
+// BatchGetUserCards hydrates up to 100 user ids into nickname and avatar cards in one call.
+func (m *defaultUserService) BatchGetUserCards(ctx context.Context, in *BatchGetUserCardsRequest, opts ...grpc.CallOption) (*BatchGetUserCardsResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.BatchGetUserCards(ctx, in, opts...)
+}
+
+// DeactivateAccount soft-deletes user_id. The account is purged once the grace period ends.
+func (m *defaultUserService) DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.DeactivateAccount(ctx, in, opts...)
+}
+
+// ReactivateAccount restores a deactivated account by password while the grace period lasts.
+func (m *defaultUserService) ReactivateAccount(ctx context.Context, in *ReactivateAccountRequest, opts ...grpc.CallOption) (*ReactivateAccountResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.ReactivateAccount(ctx, in, opts...)
+}
+
+// ChangePassword replaces user_id's password after checking the old one.
+func (m *defaultUserService) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.ChangePassword(ctx, in, opts...)
+}
+
+// RequestPasswordReset sends a reset token to a verified email or phone without revealing whether the account exists.
+func (m *defaultUserService) RequestPasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*PasswordResetResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.RequestPasswordReset(ctx, in, opts...)
+}
+
+// ResetPassword sets a new password with a token from RequestPasswordReset. Tokens are single use.
+func (m *defaultUserService) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*PasswordResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.ResetPassword(ctx, in, opts...)
+}
+
+// AdminSearchUsers pages through accounts matching the filters, newest first. Admin only.
+func (m *defaultUserService) AdminSearchUsers(ctx context.Context, in *AdminSearchUsersRequest, opts ...grpc.CallOption) (*AdminSearchUsersResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.AdminSearchUsers(ctx, in, opts...)
+}
+
+// AdminSetUserStatus disables or re-enables an account. Admin only.
+func (m *defaultUserService) AdminSetUserStatus(ctx context.Context, in *AdminSetUserStatusRequest, opts ...grpc.CallOption) (*AdminUser, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.AdminSetUserStatus(ctx, in, opts...)
+}
+
+// AdminGetUserBindings lists every login method of an account, unverified ones included. Admin only.
+func (m *defaultUserService) AdminGetUserBindings(ctx context.Context, in *AdminUserRequest, opts ...grpc.CallOption) (*AdminUserBindingsResponse, error) {
+	client := userpb.NewUserServiceClient(m.cli.Conn())
+	return client.AdminGetUserBindings(ctx, in, opts...)
+}